@@ -8,6 +8,7 @@ import (
 	"github.com/armanokka/time_tracker/pkg/db/postgres"
 	"github.com/armanokka/time_tracker/pkg/db/redis"
 	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/storage"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
@@ -52,6 +53,48 @@ import (
 // @tag.name 		tasks
 // @tag.description Tasks section
 
+// @tag.name 		entries
+// @tag.description Time entries section
+
+// @tag.name 		tags
+// @tag.description Time entry tags section
+
+// @tag.name 		rates
+// @tag.description Hourly rates section
+
+// @tag.name 		invoices
+// @tag.description Invoices section
+
+// @tag.name 		timesheets
+// @tag.description Weekly timesheets section
+
+// @tag.name 		locks
+// @tag.description Period locks section
+
+// @tag.name 		reports
+// @tag.description Reports section
+
+// @tag.name 		statuses
+// @tag.description Task statuses section
+
+// @tag.name 		budgets
+// @tag.description Project budgets section
+
+// @tag.name 		notifications
+// @tag.description Notifications section
+
+// @tag.name 		dependencies
+// @tag.description Task dependencies section
+
+// @tag.name 		comments
+// @tag.description Task comments section
+
+// @tag.name 		attachments
+// @tag.description Task attachments section
+
+// @tag.name 		labels
+// @tag.description Task labels section
+
 // @securityDefinitions.basic  BasicAuth
 
 // @externalDocs.description  OpenAPI
@@ -116,7 +159,22 @@ func main() {
 		panic(err)
 	}
 
-	if err = server.NewServer(cfg, db, rdb, log).Run(ctx); err != nil {
+	// Connecting to attachments storage
+	store, err := storage.NewStorage(ctx, &storage.Config{
+		Type:        cfg.Storage.Type,
+		Dir:         cfg.Storage.Dir,
+		S3Endpoint:  cfg.Storage.S3Endpoint,
+		S3AccessKey: cfg.Storage.S3AccessKey,
+		S3SecretKey: cfg.Storage.S3SecretKey,
+		S3Bucket:    cfg.Storage.S3Bucket,
+		S3Region:    cfg.Storage.S3Region,
+		S3UseSSL:    cfg.Storage.S3UseSSL,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err = server.NewServer(cfg, db, rdb, store, log).Run(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type PostgresConfig struct {
@@ -47,13 +48,61 @@ type TracerConfig struct {
 	OtelGRPCReceiverDSN string `env:"OTEL_GRPC_RECEIVER_DSN" env-default:"otel-collector:4317"`
 }
 
+const (
+	TimerPolicyGlobal  = "global"  // one running timer per user
+	TimerPolicyProject = "project" // one running timer per user in every project
+)
+
+type TimerConfig struct {
+	Policy string `env:"TIMER_POLICY" env-default:"project"` // global/project
+}
+
+type TasksConfig struct {
+	MaxDepth int           `env:"TASKS_MAX_DEPTH" env-default:"3"`  // levels of subtasks below top-level task, 0 disables subtasks
+	DueSoon  time.Duration `env:"TASKS_DUE_SOON" env-default:"48h"` // default window of due soon tasks
+}
+
+type StorageConfig struct {
+	Type        string `env:"STORAGE_TYPE" env-default:"local"`      // local/s3
+	Dir         string `env:"STORAGE_DIR" env-default:"attachments"` // directory of the local storage
+	S3Endpoint  string `env:"STORAGE_S3_ENDPOINT"`
+	S3AccessKey string `env:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey string `env:"STORAGE_S3_SECRET_KEY"`
+	S3Bucket    string `env:"STORAGE_S3_BUCKET" env-default:"attachments"`
+	S3Region    string `env:"STORAGE_S3_REGION" env-default:"us-east-1"`
+	S3UseSSL    bool   `env:"STORAGE_S3_USE_SSL" env-default:"false"`
+}
+
+type AttachmentsConfig struct {
+	MaxSize      int64    `env:"ATTACHMENTS_MAX_SIZE" env-default:"10485760"` // bytes
+	AllowedTypes []string `env:"ATTACHMENTS_ALLOWED_TYPES" env-separator:"," env-default:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip"`
+	// CleanupInterval is the interval of removing stored files of deleted attachments, 0 disables the worker
+	CleanupInterval time.Duration `env:"ATTACHMENTS_CLEANUP_INTERVAL" env-default:"1h"`
+}
+
+type AutoStopConfig struct {
+	Interval    time.Duration `env:"AUTO_STOP_INTERVAL" env-default:"5m"`      // 0 disables the worker
+	MaxDuration time.Duration `env:"AUTO_STOP_MAX_DURATION" env-default:"12h"` // 0 disables the limit
+}
+
+type DueRemindersConfig struct {
+	Interval time.Duration `env:"DUE_REMINDERS_INTERVAL" env-default:"5m"` // 0 disables the worker
+	Before   time.Duration `env:"DUE_REMINDERS_BEFORE" env-default:"24h"`  // how long before the due date members are reminded
+}
+
 type Config struct {
-	Postgres PostgresConfig
-	Redis    RedisConfig
-	Cookie   CookieConfig
-	Logger   LoggerConfig
-	Server   ServerConfig
-	Tracer   TracerConfig
+	Postgres     PostgresConfig
+	Redis        RedisConfig
+	Cookie       CookieConfig
+	Logger       LoggerConfig
+	Server       ServerConfig
+	Tracer       TracerConfig
+	Timer        TimerConfig
+	Tasks        TasksConfig
+	AutoStop     AutoStopConfig
+	DueReminders DueRemindersConfig
+	Storage      StorageConfig
+	Attachments  AttachmentsConfig
 }
 
 func NewConfig() (*Config, error) {
@@ -88,6 +88,105 @@ func (m Manager) ParsePathParametersMiddleware() gin.HandlerFunc {
 			}
 			c.Set("task_id", taskID)
 		}
+		if c.Param("entry_id") != "" {
+			entryID, err := strconv.ParseInt(c.Param("entry_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(entry_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid entry_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("entry_id", entryID)
+		}
+		if c.Param("tag_id") != "" {
+			tagID, err := strconv.ParseInt(c.Param("tag_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(tag_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid tag_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("tag_id", tagID)
+		}
+		if c.Param("rate_id") != "" {
+			rateID, err := strconv.ParseInt(c.Param("rate_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(rate_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid rate_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("rate_id", rateID)
+		}
+		if c.Param("invoice_id") != "" {
+			invoiceID, err := strconv.ParseInt(c.Param("invoice_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(invoice_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid invoice_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("invoice_id", invoiceID)
+		}
+		if c.Param("timesheet_id") != "" {
+			timesheetID, err := strconv.ParseInt(c.Param("timesheet_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(timesheet_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid timesheet_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("timesheet_id", timesheetID)
+		}
+		if c.Param("status_id") != "" {
+			statusID, err := strconv.ParseInt(c.Param("status_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(status_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid status_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("status_id", statusID)
+		}
+		if c.Param("notification_id") != "" {
+			notificationID, err := strconv.ParseInt(c.Param("notification_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(notification_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid notification_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("notification_id", notificationID)
+		}
+		if c.Param("blocker_id") != "" {
+			blockerID, err := strconv.ParseInt(c.Param("blocker_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(blocker_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid blocker_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("blocker_id", blockerID)
+		}
+		if c.Param("comment_id") != "" {
+			commentID, err := strconv.ParseInt(c.Param("comment_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(comment_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid comment_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("comment_id", commentID)
+		}
+		if c.Param("attachment_id") != "" {
+			attachmentID, err := strconv.ParseInt(c.Param("attachment_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(attachment_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid attachment_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("attachment_id", attachmentID)
+		}
+		if c.Param("label_id") != "" {
+			labelID, err := strconv.ParseInt(c.Param("label_id"), 10, 64)
+			if err != nil {
+				m.log.Errorf("Error c.Param(label_id) RequestID: %s, ERROR: %s,", requestid.Get(c), "invalid label_id")
+				c.AbortWithStatusJSON(http.StatusBadRequest, httpErrors.NewBadRequestError(httpErrors.BadRequest))
+				return
+			}
+			c.Set("label_id", labelID)
+		}
 	}
 }
 
@@ -160,3 +259,14 @@ func (m Manager) OwnerOrAdminMiddleware() gin.HandlerFunc {
 		return
 	}
 }
+
+func (m Manager) AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.MustGet("user").(*models.User).Admin {
+			err := httpErrors.NewForbiddenError("not enough permissions")
+			utils.LogResponseError(c, m.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+	}
+}
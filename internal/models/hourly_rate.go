@@ -0,0 +1,28 @@
+package models
+
+import "database/sql/driver"
+
+// HourlyRate is applied to billable entries started at EffectiveFrom or later, until the next rate of the same level.
+// Rate of the project member overrides rate of the project, which overrides workspace rate of the user
+type HourlyRate struct {
+	ID            int64   `json:"id" db:"id" validate:"omitempty"`
+	UserID        *int64  `json:"user_id" db:"user_id" validate:"omitempty"`
+	ProjectID     *int64  `json:"project_id" db:"project_id" validate:"omitempty"`
+	Rate          float64 `json:"rate" db:"rate" validate:"gte=0"`
+	EffectiveFrom string  `json:"effective_from" db:"effective_from" validate:"required,datetime=2006-01-02"`
+}
+
+func (rate *HourlyRate) Columns() []string {
+	return []string{"id", "user_id", "project_id", "rate", "effective_from"}
+}
+
+func (rate *HourlyRate) Fields() []driver.Value {
+	var userID, projectID driver.Value
+	if rate.UserID != nil {
+		userID = *rate.UserID
+	}
+	if rate.ProjectID != nil {
+		projectID = *rate.ProjectID
+	}
+	return []driver.Value{rate.ID, userID, projectID, rate.Rate, rate.EffectiveFrom}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+const (
+	InvoiceStatusDraft = "draft"
+	InvoiceStatusSent  = "sent"
+	InvoiceStatusPaid  = "paid"
+
+	InvoiceGroupByTask   = "task"
+	InvoiceGroupByMember = "member"
+)
+
+type Invoice struct {
+	ID         int64          `json:"id" db:"id"`
+	Number     int64          `json:"number" db:"number"`
+	ProjectID  int64          `json:"project_id" db:"project_id"`
+	CreatedBy  int64          `json:"created_by" db:"created_by"`
+	PeriodFrom time.Time      `json:"period_from" db:"period_from"`
+	PeriodTo   time.Time      `json:"period_to" db:"period_to"`
+	GroupBy    string         `json:"group_by" db:"group_by"`
+	TaxRate    float64        `json:"tax_rate" db:"tax_rate"`
+	Subtotal   float64        `json:"subtotal" db:"subtotal"`
+	Tax        float64        `json:"tax" db:"tax"`
+	Total      float64        `json:"total" db:"total"`
+	Status     string         `json:"status" db:"status"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+	SentAt     *time.Time     `json:"sent_at" db:"sent_at"`
+	PaidAt     *time.Time     `json:"paid_at" db:"paid_at"`
+	Lines      []*InvoiceLine `json:"lines,omitempty" db:"-"`
+}
+
+// InvoiceLine is time of the task or the member included in the invoice
+type InvoiceLine struct {
+	ID          int64   `json:"id" db:"id"`
+	InvoiceID   int64   `json:"invoice_id" db:"invoice_id"`
+	TaskID      *int64  `json:"task_id,omitempty" db:"task_id"`
+	UserID      *int64  `json:"user_id,omitempty" db:"user_id"`
+	Description string  `json:"description" db:"description"`
+	Seconds     int64   `json:"seconds" db:"seconds"`
+	Amount      float64 `json:"amount" db:"amount"`
+}
+
+func (invoice *Invoice) Columns() []string {
+	return []string{"id", "number", "project_id", "created_by", "period_from", "period_to", "group_by", "tax_rate",
+		"subtotal", "tax", "total", "status", "created_at", "sent_at", "paid_at"}
+}
+
+func (invoice *Invoice) Fields() []driver.Value {
+	var sentAt, paidAt driver.Value
+	if invoice.SentAt != nil {
+		sentAt = *invoice.SentAt
+	}
+	if invoice.PaidAt != nil {
+		paidAt = *invoice.PaidAt
+	}
+	return []driver.Value{invoice.ID, invoice.Number, invoice.ProjectID, invoice.CreatedBy, invoice.PeriodFrom,
+		invoice.PeriodTo, invoice.GroupBy, invoice.TaxRate, invoice.Subtotal, invoice.Tax, invoice.Total,
+		invoice.Status, invoice.CreatedAt, sentAt, paidAt}
+}
+
+func (line *InvoiceLine) Columns() []string {
+	return []string{"id", "invoice_id", "task_id", "user_id", "description", "seconds", "amount"}
+}
+
+func (line *InvoiceLine) Fields() []driver.Value {
+	var taskID, userID driver.Value
+	if line.TaskID != nil {
+		taskID = *line.TaskID
+	}
+	if line.UserID != nil {
+		userID = *line.UserID
+	}
+	return []driver.Value{line.ID, line.InvoiceID, taskID, userID, line.Description, line.Seconds, line.Amount}
+}
@@ -0,0 +1,23 @@
+package models
+
+import "database/sql/driver"
+
+// DefaultLabelColor is the color of the label created without one
+const DefaultLabelColor = "#9e9e9e"
+
+// Label marks tasks of the project, e.g. bug or feature
+type Label struct {
+	ID        int64  `json:"id" db:"id" validate:"omitempty"`
+	ProjectID int64  `json:"project_id" db:"project_id" validate:"omitempty"`
+	Name      string `json:"name" db:"name" validate:"required,lte=64"`
+	// Color is hex RGB color, it's kept if omitted on update
+	Color string `json:"color" db:"color" validate:"omitempty,hexcolor"`
+}
+
+func (label *Label) Columns() []string {
+	return []string{"id", "project_id", "name", "color"}
+}
+
+func (label *Label) Fields() []driver.Value {
+	return []driver.Value{label.ID, label.ProjectID, label.Name, label.Color}
+}
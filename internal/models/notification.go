@@ -0,0 +1,45 @@
+package models
+
+import (
+	"database/sql/driver"
+	"github.com/jmoiron/sqlx/types"
+	"time"
+)
+
+const (
+	NotificationBudgetThreshold = "budget_threshold"
+	NotificationCommentMention  = "comment_mention"
+	NotificationDueReminder     = "due_reminder"
+)
+
+// Notification is an event addressed to the user. Payload holds details specific to the type
+type Notification struct {
+	ID        int64          `json:"id" db:"id"`
+	UserID    int64          `json:"user_id" db:"user_id"`
+	Type      string         `json:"type" db:"type"`
+	ProjectID *int64         `json:"project_id" db:"project_id"`
+	TaskID    *int64         `json:"task_id" db:"task_id"`
+	Message   string         `json:"message" db:"message"`
+	Payload   types.JSONText `json:"payload" db:"payload" swaggertype:"object"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+	ReadAt    *time.Time     `json:"read_at" db:"read_at"`
+}
+
+func (notification *Notification) Columns() []string {
+	return []string{"id", "user_id", "type", "project_id", "task_id", "message", "payload", "created_at", "read_at"}
+}
+
+func (notification *Notification) Fields() []driver.Value {
+	var projectID, taskID, readAt driver.Value
+	if notification.ProjectID != nil {
+		projectID = *notification.ProjectID
+	}
+	if notification.TaskID != nil {
+		taskID = *notification.TaskID
+	}
+	if notification.ReadAt != nil {
+		readAt = *notification.ReadAt
+	}
+	return []driver.Value{notification.ID, notification.UserID, notification.Type, projectID, taskID,
+		notification.Message, []byte(notification.Payload), notification.CreatedAt, readAt}
+}
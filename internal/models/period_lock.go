@@ -0,0 +1,31 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// PeriodLock closes entries started on LockedUntil or earlier for any changes.
+// Global lock has no ProjectID, the latest of global and project locks is applied
+type PeriodLock struct {
+	ID          int64     `json:"id" db:"id"`
+	ProjectID   *int64    `json:"project_id" db:"project_id"`
+	LockedUntil string    `json:"locked_until" db:"locked_until" validate:"required,datetime=2006-01-02"`
+	UpdatedBy   *int64    `json:"updated_by" db:"updated_by"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (lock *PeriodLock) Columns() []string {
+	return []string{"id", "project_id", "locked_until", "updated_by", "updated_at"}
+}
+
+func (lock *PeriodLock) Fields() []driver.Value {
+	var projectID, updatedBy driver.Value
+	if lock.ProjectID != nil {
+		projectID = *lock.ProjectID
+	}
+	if lock.UpdatedBy != nil {
+		updatedBy = *lock.UpdatedBy
+	}
+	return []driver.Value{lock.ID, projectID, lock.LockedUntil, updatedBy, lock.UpdatedAt}
+}
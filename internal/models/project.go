@@ -7,12 +7,31 @@ type Project struct {
 	Name        string  `json:"name" db:"name" validate:"lte=64"`
 	Description *string `json:"description" db:"description" validate:"omitempty,lte=1024"`
 	CreatorID   int64   `json:"creator_id" db:"creator_id" validate:"omitempty"`
+	// Billable is the default billable flag of the project time entries
+	Billable *bool `json:"billable" db:"billable" validate:"omitempty"`
+	// StartInProgress moves todo tasks to the first in progress status when their timer is started
+	StartInProgress *bool `json:"start_in_progress" db:"start_in_progress" validate:"omitempty"`
+	// WarnBlockedStart allows starting tasks with unfinished blockers, they are refused by default
+	WarnBlockedStart *bool `json:"warn_blocked_start" db:"warn_blocked_start" validate:"omitempty"`
 }
 
 func (project *Project) Columns() []string {
-	return []string{"id", "name", "description", "creator_id"}
+	return []string{"id", "name", "description", "creator_id", "billable", "start_in_progress", "warn_blocked_start"}
 }
 
 func (project *Project) Fields() []driver.Value {
-	return []driver.Value{project.ID, project.Name, project.Description, project.CreatorID}
+	var billable driver.Value
+	if project.Billable != nil {
+		billable = *project.Billable
+	}
+	var startInProgress driver.Value
+	if project.StartInProgress != nil {
+		startInProgress = *project.StartInProgress
+	}
+	var warnBlockedStart driver.Value
+	if project.WarnBlockedStart != nil {
+		warnBlockedStart = *project.WarnBlockedStart
+	}
+	return []driver.Value{project.ID, project.Name, project.Description, project.CreatorID, billable, startInProgress,
+		warnBlockedStart}
 }
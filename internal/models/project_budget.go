@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	BudgetKindHours = "hours" // amount of the budget is tracked hours
+	BudgetKindMoney = "money" // amount of the budget is billable amount of the entries
+
+	BudgetPeriodTotal   = "total"
+	BudgetPeriodMonthly = "monthly"
+)
+
+// ProjectBudget limits tracked hours or money of the project, in total or per calendar month.
+// The owner is notified when consumption crosses the thresholds in percents
+type ProjectBudget struct {
+	ProjectID  int64         `json:"project_id" db:"project_id"`
+	Kind       string        `json:"kind" db:"kind"`
+	Amount     float64       `json:"amount" db:"amount"`
+	Period     string        `json:"period" db:"period"`
+	Thresholds pq.Int64Array `json:"thresholds" db:"thresholds"`
+	// BlockStart refuses starting timers of the project for non-owners when the budget is exceeded
+	BlockStart bool      `json:"block_start" db:"block_start"`
+	UpdatedBy  *int64    `json:"updated_by" db:"updated_by"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (budget *ProjectBudget) Columns() []string {
+	return []string{"project_id", "kind", "amount", "period", "thresholds", "block_start", "updated_by", "updated_at"}
+}
+
+func (budget *ProjectBudget) Fields() []driver.Value {
+	var updatedBy driver.Value
+	if budget.UpdatedBy != nil {
+		updatedBy = *budget.UpdatedBy
+	}
+	thresholds, _ := budget.Thresholds.Value()
+	return []driver.Value{budget.ProjectID, budget.Kind, budget.Amount, budget.Period, thresholds, budget.BlockStart,
+		updatedBy, budget.UpdatedAt}
+}
+
+// PeriodStart returns start of the current budget period in UTC, nil for total budget
+func (budget *ProjectBudget) PeriodStart(now time.Time) *time.Time {
+	if budget.Period != BudgetPeriodMonthly {
+		return nil
+	}
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return &start
+}
+
+// BudgetConsumption is tracked time and billable amount of the project in the budget period.
+// Consumed and Remaining are in hours or money depending on kind of the budget
+type BudgetConsumption struct {
+	ProjectID      int64      `json:"project_id"`
+	Kind           string     `json:"kind"`
+	Period         string     `json:"period"`
+	PeriodStart    *time.Time `json:"period_start"`
+	Budget         float64    `json:"budget"`
+	TrackedSeconds int64      `json:"tracked_seconds" db:"tracked_seconds"`
+	Amount         float64    `json:"amount" db:"amount"`
+	Consumed       float64    `json:"consumed"`
+	Remaining      float64    `json:"remaining"`
+	Percent        float64    `json:"percent"`
+	Exceeded       bool       `json:"exceeded"`
+}
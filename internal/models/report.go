@@ -0,0 +1,47 @@
+package models
+
+import "database/sql/driver"
+
+// Report is tracked time grouped by one or two dimensions with subtotals of each group
+type Report struct {
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	GroupBy         []string       `json:"group_by"`
+	TotalSeconds    int64          `json:"total_seconds"`
+	BillableSeconds int64          `json:"billable_seconds"`
+	Groups          []*ReportGroup `json:"groups"`
+}
+
+// ReportGroup is identified by ID of the project, task or user, or by date of the period
+type ReportGroup struct {
+	Key             string         `json:"key"`
+	Name            string         `json:"name"`
+	TotalSeconds    int64          `json:"total_seconds"`
+	BillableSeconds int64          `json:"billable_seconds"`
+	Groups          []*ReportGroup `json:"groups,omitempty"`
+}
+
+// ReportRow is tracked time of the first and the optional second dimension
+type ReportRow struct {
+	Key1            string  `db:"key1"`
+	Name1           string  `db:"name1"`
+	Key2            *string `db:"key2"`
+	Name2           *string `db:"name2"`
+	TotalSeconds    int64   `db:"total_seconds"`
+	BillableSeconds int64   `db:"billable_seconds"`
+}
+
+func (row *ReportRow) Columns() []string {
+	return []string{"key1", "name1", "key2", "name2", "total_seconds", "billable_seconds"}
+}
+
+func (row *ReportRow) Fields() []driver.Value {
+	var key2, name2 driver.Value
+	if row.Key2 != nil {
+		key2 = *row.Key2
+	}
+	if row.Name2 != nil {
+		name2 = *row.Name2
+	}
+	return []driver.Value{row.Key1, row.Name1, key2, name2, row.TotalSeconds, row.BillableSeconds}
+}
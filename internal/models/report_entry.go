@@ -0,0 +1,43 @@
+package models
+
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+	"time"
+)
+
+// ReportEntry is a time entry with names of its project, task, user and tags for raw entries reports
+type ReportEntry struct {
+	ID             int64          `json:"id" db:"id"`
+	ProjectID      int64          `json:"project_id" db:"project_id"`
+	ProjectName    string         `json:"project_name" db:"project_name"`
+	TaskID         int64          `json:"task_id" db:"task_id"`
+	TaskName       string         `json:"task_name" db:"task_name"`
+	UserID         int64          `json:"user_id" db:"user_id"`
+	UserName       string         `json:"user_name" db:"user_name"`
+	StartedAt      time.Time      `json:"started_at" db:"started_at"`
+	EndedAt        *time.Time     `json:"ended_at" db:"ended_at"`
+	LocalStartedAt time.Time      `json:"-" db:"local_started_at"`
+	Seconds        int64          `json:"seconds" db:"seconds"`
+	Billable       bool           `json:"billable" db:"billable"`
+	Description    *string        `json:"description" db:"description"`
+	Tags           pq.StringArray `json:"tags" db:"tags"`
+}
+
+func (entry *ReportEntry) Columns() []string {
+	return []string{"id", "project_id", "project_name", "task_id", "task_name", "user_id", "user_name", "started_at",
+		"ended_at", "local_started_at", "seconds", "billable", "description", "tags"}
+}
+
+func (entry *ReportEntry) Fields() []driver.Value {
+	var endedAt, description driver.Value
+	if entry.EndedAt != nil {
+		endedAt = *entry.EndedAt
+	}
+	if entry.Description != nil {
+		description = *entry.Description
+	}
+	tags, _ := entry.Tags.Value()
+	return []driver.Value{entry.ID, entry.ProjectID, entry.ProjectName, entry.TaskID, entry.TaskName, entry.UserID,
+		entry.UserName, entry.StartedAt, endedAt, entry.LocalStartedAt, entry.Seconds, entry.Billable, description, tags}
+}
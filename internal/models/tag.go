@@ -0,0 +1,17 @@
+package models
+
+import "database/sql/driver"
+
+type Tag struct {
+	ID        int64  `json:"id" db:"id" validate:"omitempty"`
+	ProjectID int64  `json:"project_id" db:"project_id" validate:"omitempty"`
+	Name      string `json:"name" db:"name" validate:"required,lte=64"`
+}
+
+func (tag *Tag) Columns() []string {
+	return []string{"id", "project_id", "name"}
+}
+
+func (tag *Tag) Fields() []driver.Value {
+	return []driver.Value{tag.ID, tag.ProjectID, tag.Name}
+}
@@ -1,25 +1,84 @@
 package models
 
-import "database/sql/driver"
+import (
+	"database/sql/driver"
+	"time"
+)
 
 type Task struct {
 	ID          int64  `json:"id" db:"id" validate:"omitempty"`
 	Name        string `json:"name" db:"name" validate:"lte=64"`
 	Description string `json:"description" db:"description" validate:"omitempty,lte=256"`
 	ProjectID   int64  `json:"project_id" db:"project_id" validate:"omitempty"`
-	Finished    bool   `json:"finished" db:"finished"`
+	// Billable overrides billable flag of the project, null means inherited
+	Billable *bool `json:"billable" db:"billable" validate:"omitempty"`
+	// EstimateSeconds is estimated duration of the task, null means no estimate. Zero removes the estimate on update
+	EstimateSeconds *int64 `json:"estimate_seconds" db:"estimate_seconds" validate:"omitempty,gte=0"`
+	// StatusID is the workflow status of the task, the first todo status of the project by default
+	StatusID  int64     `json:"status_id" db:"status_id" validate:"omitempty"`
+	CreatedAt time.Time `json:"created_at" db:"created_at" validate:"omitempty"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at" validate:"omitempty"`
+	// ParentID makes the task a subtask of another task of the project. Zero makes it top-level task on update
+	ParentID *int64 `json:"parent_id" db:"parent_id" validate:"omitempty,gte=0"`
+	// StartAt and DueAt are planned dates of the task
+	StartAt *time.Time `json:"start_at" db:"start_at" validate:"omitempty"`
+	DueAt   *time.Time `json:"due_at" db:"due_at" validate:"omitempty"`
+	// ClearStartAt and ClearDueAt remove the planned dates on update
+	ClearStartAt bool `json:"clear_start_at,omitempty" db:"-" validate:"omitempty"`
+	ClearDueAt   bool `json:"clear_due_at,omitempty" db:"-" validate:"omitempty"`
+	// Priority is from 1 (low) to 4 (urgent), medium by default
+	Priority int `json:"priority" db:"priority" validate:"omitempty,gte=1,lte=4"`
+	// Rank orders tasks within their status column on the board, it's changed by moving the task
+	Rank string `json:"rank" db:"rank" validate:"omitempty"`
 }
 
+const (
+	TaskPriorityLow    = 1
+	TaskPriorityMedium = 2
+	TaskPriorityHigh   = 3
+	TaskPriorityUrgent = 4
+)
+
 func (task *Task) Columns() []string {
-	return []string{"id", "name", "description", "project_id", "finished"}
+	return []string{"id", "name", "description", "project_id", "billable", "estimate_seconds", "status_id", "created_at",
+		"updated_at", "parent_id", "start_at", "due_at", "priority", "rank"}
 }
 
 func (task *Task) Fields() []driver.Value {
-	return []driver.Value{task.ID, task.Name, task.Description, task.ProjectID, task.Finished}
+	var billable driver.Value
+	if task.Billable != nil {
+		billable = *task.Billable
+	}
+	var estimateSeconds driver.Value
+	if task.EstimateSeconds != nil {
+		estimateSeconds = *task.EstimateSeconds
+	}
+	var parentID driver.Value
+	if task.ParentID != nil {
+		parentID = *task.ParentID
+	}
+	var startAt, dueAt driver.Value
+	if task.StartAt != nil {
+		startAt = *task.StartAt
+	}
+	if task.DueAt != nil {
+		dueAt = *task.DueAt
+	}
+	return []driver.Value{task.ID, task.Name, task.Description, task.ProjectID, billable, estimateSeconds, task.StatusID,
+		task.CreatedAt, task.UpdatedAt, parentID, startAt, dueAt, task.Priority, task.Rank}
+}
+
+// DueTasks are unfinished tasks of the user with due dates, overdue ones are past the due date
+type DueTasks struct {
+	Overdue []*Task `json:"overdue"`
+	DueSoon []*Task `json:"due_soon"`
 }
 
 type UserProductivity struct {
-	TaskID       int64 `json:"task_id"`
-	SpentHours   int   `json:"spent_hours"`
-	SpentMinutes int   `json:"spent_minutes"`
+	TaskID          int64   `json:"task_id,omitempty"`
+	TagID           int64   `json:"tag_id,omitempty"`
+	SpentHours      int     `json:"spent_hours"`
+	SpentMinutes    int     `json:"spent_minutes"`
+	BillableSeconds int     `json:"billable_seconds"`
+	Amount          float64 `json:"amount"`
 }
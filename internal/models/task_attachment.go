@@ -0,0 +1,27 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// TaskAttachment is a file attached to the task. StorageKey is the key of the file contents in the storage
+type TaskAttachment struct {
+	ID          int64     `json:"id" db:"id"`
+	TaskID      int64     `json:"task_id" db:"task_id"`
+	UploaderID  int64     `json:"uploader_id" db:"uploader_id"`
+	Name        string    `json:"name" db:"name"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	StorageKey  string    `json:"-" db:"storage_key"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+func (attachment *TaskAttachment) Columns() []string {
+	return []string{"id", "task_id", "uploader_id", "name", "content_type", "size", "storage_key", "created_at"}
+}
+
+func (attachment *TaskAttachment) Fields() []driver.Value {
+	return []driver.Value{attachment.ID, attachment.TaskID, attachment.UploaderID, attachment.Name,
+		attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.CreatedAt}
+}
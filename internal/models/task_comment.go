@@ -0,0 +1,33 @@
+package models
+
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+	"time"
+)
+
+// TaskComment is a markdown comment of the task. Mentions are ids of project members and the owner
+// mentioned by numeric id as @<user_id>, names aren't resolved
+type TaskComment struct {
+	ID        int64         `json:"id" db:"id" validate:"omitempty"`
+	TaskID    int64         `json:"task_id" db:"task_id" validate:"omitempty"`
+	AuthorID  int64         `json:"author_id" db:"author_id" validate:"omitempty"`
+	Body      string        `json:"body" db:"body" validate:"required,lte=10000"`
+	Mentions  pq.Int64Array `json:"mentions" db:"mentions" validate:"omitempty"`
+	CreatedAt time.Time     `json:"created_at" db:"created_at" validate:"omitempty"`
+	EditedAt  *time.Time    `json:"edited_at" db:"edited_at" validate:"omitempty"`
+}
+
+func (comment *TaskComment) Columns() []string {
+	return []string{"id", "task_id", "author_id", "body", "mentions", "created_at", "edited_at"}
+}
+
+func (comment *TaskComment) Fields() []driver.Value {
+	var editedAt driver.Value
+	if comment.EditedAt != nil {
+		editedAt = *comment.EditedAt
+	}
+	mentions, _ := comment.Mentions.Value()
+	return []driver.Value{comment.ID, comment.TaskID, comment.AuthorID, comment.Body, mentions, comment.CreatedAt,
+		editedAt}
+}
@@ -0,0 +1,40 @@
+package models
+
+import "database/sql/driver"
+
+// TaskDependency means the task can't be started until its blocker is done
+type TaskDependency struct {
+	TaskID    int64 `json:"task_id" db:"task_id"`
+	BlockerID int64 `json:"blocker_id" db:"blocker_id"`
+}
+
+func (dependency *TaskDependency) Columns() []string {
+	return []string{"task_id", "blocker_id"}
+}
+
+func (dependency *TaskDependency) Fields() []driver.Value {
+	return []driver.Value{dependency.TaskID, dependency.BlockerID}
+}
+
+// DependencyTask is the task in dependencies, Done tells whether its status is in done category
+type DependencyTask struct {
+	ID       int64  `json:"id" db:"id"`
+	Name     string `json:"name" db:"name"`
+	StatusID int64  `json:"status_id" db:"status_id"`
+	Done     bool   `json:"done" db:"done"`
+}
+
+func (task *DependencyTask) Columns() []string {
+	return []string{"id", "name", "status_id", "done"}
+}
+
+func (task *DependencyTask) Fields() []driver.Value {
+	return []driver.Value{task.ID, task.Name, task.StatusID, task.Done}
+}
+
+// DependencyGraph is the tasks of the project having blockers or blocking other tasks and the dependencies between them
+type DependencyGraph struct {
+	ProjectID    int64             `json:"project_id"`
+	Tasks        []*DependencyTask `json:"tasks"`
+	Dependencies []*TaskDependency `json:"dependencies"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// TaskDetail is the task with its members, direct subtasks and time tracked in entries of the task and all its subtasks.
+// OwnSeconds is tracked in the task itself. Running entries are counted until CalculatedAt, so cache is slightly behind
+type TaskDetail struct {
+	Task
+	Members         []*User            `json:"members"`
+	Subtasks        []*Task            `json:"subtasks"`
+	BlockedBy       []*DependencyTask  `json:"blocked_by"`
+	Labels          []*Label           `json:"labels"`
+	TotalSeconds    int64              `json:"total_seconds"`
+	BillableSeconds int64              `json:"billable_seconds"`
+	OwnSeconds      int64              `json:"own_seconds"`
+	MembersTime     []*TaskMemberTime  `json:"members_time"`
+	RunningEntries  []*ActiveTimeEntry `json:"running_entries"`
+	CalculatedAt    time.Time          `json:"calculated_at"`
+}
+
+// TaskMemberTime is time tracked by the user in the task and its subtasks
+type TaskMemberTime struct {
+	UserID          int64 `json:"user_id" db:"user_id"`
+	TotalSeconds    int64 `json:"total_seconds" db:"total_seconds"`
+	BillableSeconds int64 `json:"billable_seconds" db:"billable_seconds"`
+	OwnSeconds      int64 `json:"own_seconds" db:"own_seconds"`
+	EntriesCount    int64 `json:"entries_count" db:"entries_count"`
+}
+
+func (memberTime *TaskMemberTime) Columns() []string {
+	return []string{"user_id", "total_seconds", "billable_seconds", "own_seconds", "entries_count"}
+}
+
+func (memberTime *TaskMemberTime) Fields() []driver.Value {
+	return []driver.Value{memberTime.UserID, memberTime.TotalSeconds, memberTime.BillableSeconds,
+		memberTime.OwnSeconds, memberTime.EntriesCount}
+}
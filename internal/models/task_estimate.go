@@ -0,0 +1,44 @@
+package models
+
+import "database/sql/driver"
+
+// TaskEstimate compares estimated duration of the task with time tracked in its entries.
+// Remaining and variance are null for tasks without estimate, positive variance means the task is over its estimate
+type TaskEstimate struct {
+	TaskID           int64  `json:"task_id" db:"task_id"`
+	Name             string `json:"name" db:"name"`
+	EstimateSeconds  *int64 `json:"estimate_seconds" db:"estimate_seconds"`
+	ActualSeconds    int64  `json:"actual_seconds" db:"actual_seconds"`
+	RemainingSeconds *int64 `json:"remaining_seconds" db:"remaining_seconds"`
+	VarianceSeconds  *int64 `json:"variance_seconds" db:"variance_seconds"`
+}
+
+func (estimate *TaskEstimate) Columns() []string {
+	return []string{"task_id", "name", "estimate_seconds", "actual_seconds", "remaining_seconds", "variance_seconds"}
+}
+
+func (estimate *TaskEstimate) Fields() []driver.Value {
+	var estimateSeconds, remainingSeconds, varianceSeconds driver.Value
+	if estimate.EstimateSeconds != nil {
+		estimateSeconds = *estimate.EstimateSeconds
+	}
+	if estimate.RemainingSeconds != nil {
+		remainingSeconds = *estimate.RemainingSeconds
+	}
+	if estimate.VarianceSeconds != nil {
+		varianceSeconds = *estimate.VarianceSeconds
+	}
+	return []driver.Value{estimate.TaskID, estimate.Name, estimateSeconds, estimate.ActualSeconds, remainingSeconds,
+		varianceSeconds}
+}
+
+// ProjectEstimate sums estimates of the project tasks. Remaining and variance are summed over estimated tasks only,
+// ActualSeconds includes tasks without estimate
+type ProjectEstimate struct {
+	ProjectID        int64           `json:"project_id"`
+	EstimateSeconds  int64           `json:"estimate_seconds"`
+	ActualSeconds    int64           `json:"actual_seconds"`
+	RemainingSeconds int64           `json:"remaining_seconds"`
+	VarianceSeconds  int64           `json:"variance_seconds"`
+	Tasks            []*TaskEstimate `json:"tasks"`
+}
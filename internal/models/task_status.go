@@ -0,0 +1,32 @@
+package models
+
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+)
+
+const (
+	TaskStatusTodo       = "todo"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusDone       = "done" // moving task to done status stops its running entries
+)
+
+// TaskStatus is a step of the project workflow. Transitions are statuses the task can be moved to,
+// status without transitions allows moving to any status of the project
+type TaskStatus struct {
+	ID          int64         `json:"id" db:"id"`
+	ProjectID   int64         `json:"project_id" db:"project_id"`
+	Name        string        `json:"name" db:"name"`
+	Category    string        `json:"category" db:"category"`
+	Position    int           `json:"position" db:"position"`
+	Transitions pq.Int64Array `json:"transitions" db:"transitions"`
+}
+
+func (status *TaskStatus) Columns() []string {
+	return []string{"id", "project_id", "name", "category", "position", "transitions"}
+}
+
+func (status *TaskStatus) Fields() []driver.Value {
+	transitions, _ := status.Transitions.Value()
+	return []driver.Value{status.ID, status.ProjectID, status.Name, status.Category, status.Position, transitions}
+}
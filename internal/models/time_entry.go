@@ -1,11 +1,63 @@
 package models
 
-import "time"
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+	"time"
+)
 
 type TimeEntry struct {
-	ID        int64     `json:"id" db:"id"`
-	TaskID    int64     `json:"task_id" db:"task_id"`
-	UserID    int64     `json:"user_id" db:"user_id"`
-	StartedAt time.Time `json:"started_at" db:"started_at"`
-	EndedAt   time.Time `json:"ended_at" db:"ended_at"`
+	ID          int64         `json:"id" db:"id"`
+	TaskID      int64         `json:"task_id" db:"task_id"`
+	UserID      int64         `json:"user_id" db:"user_id"`
+	StartedAt   time.Time     `json:"started_at" db:"started_at"`
+	EndedAt     *time.Time    `json:"ended_at" db:"ended_at"`
+	AutoStopped bool          `json:"auto_stopped" db:"auto_stopped"`
+	Description *string       `json:"description" db:"description"`
+	TagIDs      pq.Int64Array `json:"tag_ids" db:"tag_ids"`
+	Billable    *bool         `json:"billable" db:"billable"`
+	// InvoiceID is set when the entry is invoiced, such entries can't be changed
+	InvoiceID *int64 `json:"invoice_id" db:"invoice_id"`
+}
+
+// ActiveTimeEntry is a running time entry with its task and project
+type ActiveTimeEntry struct {
+	TimeEntry
+	TaskName    string `json:"task_name" db:"task_name"`
+	ProjectID   int64  `json:"project_id" db:"project_id"`
+	ProjectName string `json:"project_name" db:"project_name"`
+	Paused      bool   `json:"paused" db:"paused"`
+}
+
+func (entry *TimeEntry) Columns() []string {
+	return []string{"id", "task_id", "user_id", "started_at", "ended_at", "auto_stopped", "description", "billable",
+		"invoice_id"}
+}
+
+func (entry *TimeEntry) Fields() []driver.Value {
+	var endedAt driver.Value
+	if entry.EndedAt != nil {
+		endedAt = *entry.EndedAt
+	}
+	var description driver.Value
+	if entry.Description != nil {
+		description = *entry.Description
+	}
+	var billable driver.Value
+	if entry.Billable != nil {
+		billable = *entry.Billable
+	}
+	var invoiceID driver.Value
+	if entry.InvoiceID != nil {
+		invoiceID = *entry.InvoiceID
+	}
+	return []driver.Value{entry.ID, entry.TaskID, entry.UserID, entry.StartedAt, endedAt, entry.AutoStopped,
+		description, billable, invoiceID}
+}
+
+// TimerSettings are used to stop forgotten timers of the user
+type TimerSettings struct {
+	UserID     int64   `json:"user_id" db:"user_id" validate:"omitempty"`
+	WorkdayEnd *string `json:"workday_end" db:"workday_end" validate:"omitempty,datetime=15:04"`
+	Timezone   string  `json:"timezone" db:"timezone" validate:"omitempty,timezone"`
 }
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+const (
+	TimesheetStatusSubmitted = "submitted"
+	TimesheetStatusApproved  = "approved"
+	TimesheetStatusRejected  = "rejected"
+)
+
+// Timesheet is a week of the member entries in the project submitted for approval.
+// Entries of approved week can't be changed
+type Timesheet struct {
+	ID           int64      `json:"id" db:"id"`
+	ProjectID    int64      `json:"project_id" db:"project_id"`
+	UserID       int64      `json:"user_id" db:"user_id"`
+	WeekStart    time.Time  `json:"week_start" db:"week_start"`
+	Status       string     `json:"status" db:"status"`
+	Comment      *string    `json:"comment" db:"comment"`
+	SubmittedAt  time.Time  `json:"submitted_at" db:"submitted_at"`
+	ReviewedBy   *int64     `json:"reviewed_by" db:"reviewed_by"`
+	ReviewedAt   *time.Time `json:"reviewed_at" db:"reviewed_at"`
+	TotalSeconds int64      `json:"total_seconds" db:"total_seconds"`
+}
+
+func (timesheet *Timesheet) Columns() []string {
+	return []string{"id", "project_id", "user_id", "week_start", "status", "comment", "submitted_at", "reviewed_by",
+		"reviewed_at", "total_seconds"}
+}
+
+func (timesheet *Timesheet) Fields() []driver.Value {
+	var comment, reviewedBy, reviewedAt driver.Value
+	if timesheet.Comment != nil {
+		comment = *timesheet.Comment
+	}
+	if timesheet.ReviewedBy != nil {
+		reviewedBy = *timesheet.ReviewedBy
+	}
+	if timesheet.ReviewedAt != nil {
+		reviewedAt = *timesheet.ReviewedAt
+	}
+	return []driver.Value{timesheet.ID, timesheet.ProjectID, timesheet.UserID, timesheet.WeekStart, timesheet.Status,
+		comment, timesheet.SubmittedAt, reviewedBy, reviewedAt, timesheet.TotalSeconds}
+}
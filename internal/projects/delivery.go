@@ -17,14 +17,135 @@ type Handlers interface {
 
 type TaskHandlers interface {
 	Get() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
 	Create() gin.HandlerFunc
 	Update() gin.HandlerFunc
+	Move() gin.HandlerFunc
+	Bulk() gin.HandlerFunc
 	Delete() gin.HandlerFunc
 
+	GetEstimates() gin.HandlerFunc
+	GetOverEstimate() gin.HandlerFunc
+	GetDue() gin.HandlerFunc
+
 	Start() gin.HandlerFunc
 	Stop() gin.HandlerFunc
+	Pause() gin.HandlerFunc
+	Resume() gin.HandlerFunc
 
 	GetMembers() gin.HandlerFunc
 	AddMember() gin.HandlerFunc
 	DeleteMember() gin.HandlerFunc
 }
+
+type TimeEntryHandlers interface {
+	Get() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+
+	GetActive() gin.HandlerFunc
+	Switch() gin.HandlerFunc
+	GetSettings() gin.HandlerFunc
+	UpdateSettings() gin.HandlerFunc
+}
+
+type InvoiceHandlers interface {
+	Get() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
+	Download() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	UpdateStatus() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+}
+
+type RateHandlers interface {
+	GetUserRates() gin.HandlerFunc
+	CreateUserRate() gin.HandlerFunc
+	DeleteUserRate() gin.HandlerFunc
+
+	GetProjectRates() gin.HandlerFunc
+	CreateProjectRate() gin.HandlerFunc
+	DeleteProjectRate() gin.HandlerFunc
+}
+
+type LabelHandlers interface {
+	Get() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+	Attach() gin.HandlerFunc
+	Detach() gin.HandlerFunc
+}
+
+type TagHandlers interface {
+	Get() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+}
+
+type TimesheetHandlers interface {
+	Get() gin.HandlerFunc
+	GetPending() gin.HandlerFunc
+	Submit() gin.HandlerFunc
+	Approve() gin.HandlerFunc
+	Reject() gin.HandlerFunc
+}
+
+type LockHandlers interface {
+	Get() gin.HandlerFunc
+	SetGlobal() gin.HandlerFunc
+	DeleteGlobal() gin.HandlerFunc
+
+	GetProjectLock() gin.HandlerFunc
+	SetProjectLock() gin.HandlerFunc
+	DeleteProjectLock() gin.HandlerFunc
+}
+
+type ReportHandlers interface {
+	Get() gin.HandlerFunc
+	GetEntries() gin.HandlerFunc
+}
+
+type BudgetHandlers interface {
+	Get() gin.HandlerFunc
+	Set() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+	GetConsumption() gin.HandlerFunc
+}
+
+type NotificationHandlers interface {
+	Get() gin.HandlerFunc
+	Read() gin.HandlerFunc
+	ReadAll() gin.HandlerFunc
+}
+
+type StatusHandlers interface {
+	Get() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+}
+
+type DependencyHandlers interface {
+	Get() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+	GetGraph() gin.HandlerFunc
+}
+
+type CommentHandlers interface {
+	Get() gin.HandlerFunc
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+}
+
+type AttachmentHandlers interface {
+	Get() gin.HandlerFunc
+	Upload() gin.HandlerFunc
+	Download() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+}
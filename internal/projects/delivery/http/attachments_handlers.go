@@ -0,0 +1,190 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/armanokka/time_tracker/config"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"mime"
+	"net/http"
+)
+
+// multipartOverhead is the room for multipart boundaries and headers around the uploaded file
+const multipartOverhead = 1 << 20
+
+type attachmentsHandlers struct {
+	cfg           config.AttachmentsConfig
+	attachmentsUC projects.AttachmentsUseCase
+	log           logger.Logger
+	tracer        trace.Tracer
+}
+
+func NewAttachmentsHandlers(cfg config.AttachmentsConfig, attachmentsUC projects.AttachmentsUseCase,
+	log logger.Logger) projects.AttachmentHandlers {
+	return attachmentsHandlers{
+		cfg:           cfg,
+		attachmentsUC: attachmentsUC,
+		log:           log,
+		tracer:        otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+// Get godoc
+// @Summary      Get task attachments
+// @Description  Get files attached to the task
+// @Tags		 attachments
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.TaskAttachment
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/attachments [get]
+func (h attachmentsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "attachmentsHandlers.Get")
+		defer span.End()
+
+		attachments, err := h.attachmentsUC.Get(ctx, c.GetInt64("task_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, attachments)
+	}
+}
+
+// Upload godoc
+// @Summary      Attach file to task
+// @Description  Upload the file and attach it to the task. File size and content types are limited by the server settings
+// @Tags		 attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        file formData file true "file to be attached"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskAttachment
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      413  {object}  httpErrors.RestError
+// @Failure      415  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/attachments [post]
+func (h attachmentsHandlers) Upload() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "attachmentsHandlers.Upload")
+		defer span.End()
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.cfg.MaxSize+multipartOverhead)
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				err = httpErrors.NewRestError(http.StatusRequestEntityTooLarge, httpErrors.FileTooLarge.Error(), err)
+			} else {
+				err = httpErrors.NewBadRequestError(err.Error())
+			}
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		defer file.Close()
+
+		attachment, err := h.attachmentsUC.Upload(ctx, c.GetInt64("project_id"), &models.TaskAttachment{
+			TaskID:     c.GetInt64("task_id"),
+			UploaderID: c.MustGet("user").(*models.User).ID,
+			Name:       fileHeader.Filename,
+			Size:       fileHeader.Size,
+		}, file)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, attachment)
+	}
+}
+
+// Download godoc
+// @Summary      Download task attachment
+// @Description  Download the file attached to the task
+// @Tags		 attachments
+// @Produce      octet-stream
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        attachment_id path string true "attachment id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {file}  file
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/attachments/{attachment_id} [get]
+func (h attachmentsHandlers) Download() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "attachmentsHandlers.Download")
+		defer span.End()
+
+		attachment, file, err := h.attachmentsUC.Download(ctx, c.GetInt64("project_id"), c.GetInt64("task_id"),
+			c.GetInt64("attachment_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		defer file.Close()
+
+		disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name})
+		if disposition == "" {
+			disposition = fmt.Sprintf(`attachment; filename="attachment-%d"`, attachment.ID)
+		}
+		c.DataFromReader(200, attachment.Size, attachment.ContentType, file, map[string]string{
+			"Content-Disposition": disposition,
+		})
+	}
+}
+
+// Delete godoc
+// @Summary      Delete task attachment
+// @Description  Delete the file attached to the task. It's allowed to its uploader, project owner and admins
+// @Tags		 attachments
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        attachment_id path string true "attachment id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/attachments/{attachment_id} [delete]
+func (h attachmentsHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "attachmentsHandlers.Delete")
+		defer span.End()
+
+		if err := h.attachmentsUC.Delete(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"),
+			c.GetInt64("task_id"), c.GetInt64("attachment_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
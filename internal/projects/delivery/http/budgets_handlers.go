@@ -0,0 +1,147 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type budgetsHandlers struct {
+	budgetsUC projects.BudgetsUseCase
+	log       logger.Logger
+	tracer    trace.Tracer
+}
+
+func NewBudgetsHandlers(budgetsUC projects.BudgetsUseCase, log logger.Logger) projects.BudgetHandlers {
+	return budgetsHandlers{budgetsUC: budgetsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project budget
+// @Description  Get budget of the project
+// @Tags		 budgets
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.ProjectBudget
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/budget [get]
+func (h budgetsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "budgetsHandlers.Get")
+		defer span.End()
+
+		budget, err := h.budgetsUC.GetByProject(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, budget)
+	}
+}
+
+// Set godoc
+// @Summary      Set project budget
+// @Description  Set budget of the project in hours or money, total or per calendar month. The owner is notified when stopped timers cross the thresholds. Crossed thresholds are reset
+// @Tags		 budgets
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 budgetBody body  http.SetBudgetRequest true "budget of the project"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.ProjectBudget
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/budget [put]
+func (h budgetsHandlers) Set() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "budgetsHandlers.Set")
+		defer span.End()
+
+		req := &SetBudgetRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		user := c.MustGet("user").(*models.User)
+		budget, err := h.budgetsUC.Set(ctx, &models.ProjectBudget{
+			ProjectID:  c.GetInt64("project_id"),
+			Kind:       req.Kind,
+			Amount:     req.Amount,
+			Period:     req.Period,
+			Thresholds: req.Thresholds,
+			BlockStart: req.BlockStart,
+			UpdatedBy:  &user.ID,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, budget)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete project budget
+// @Description  Delete budget of the project
+// @Tags		 budgets
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/budget [delete]
+func (h budgetsHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "budgetsHandlers.Delete")
+		defer span.End()
+
+		if err := h.budgetsUC.Delete(ctx, c.GetInt64("project_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// GetConsumption godoc
+// @Summary      Get project budget consumption
+// @Description  Get tracked time and billable amount of the current budget period compared with the budget. Running timers are counted until now
+// @Tags		 budgets
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.BudgetConsumption
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/budget/consumption [get]
+func (h budgetsHandlers) GetConsumption() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "budgetsHandlers.GetConsumption")
+		defer span.End()
+
+		consumption, err := h.budgetsUC.GetConsumption(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, consumption)
+	}
+}
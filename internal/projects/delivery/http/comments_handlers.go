@@ -0,0 +1,168 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type commentsHandlers struct {
+	commentsUC projects.CommentsUseCase
+	log        logger.Logger
+	tracer     trace.Tracer
+}
+
+func NewCommentsHandlers(commentsUC projects.CommentsUseCase, log logger.Logger) projects.CommentHandlers {
+	return commentsHandlers{commentsUC: commentsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get task comments
+// @Description  Get comments of the task from the oldest one
+// @Tags		 comments
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        after query int false "id of the last comment of the previous page"
+// @Param        limit query int false "number of comments, 100 by default"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.TaskComment
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/comments [get]
+func (h commentsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "commentsHandlers.Get")
+		defer span.End()
+
+		query := &utils.CommentsQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		comments, err := h.commentsUC.Get(ctx, c.GetInt64("task_id"), query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, comments)
+	}
+}
+
+// Create godoc
+// @Summary      Comment task
+// @Description  Comment the task. Body is markdown. Mentions are numeric only: project members and the owner mentioned by id as @<user_id>, e.g. @42, are notified, other @ tokens are plain text
+// @Tags		 comments
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 commentBody body  models.TaskComment true "comment to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskComment
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/comments [post]
+func (h commentsHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "commentsHandlers.Create")
+		defer span.End()
+
+		comment := &models.TaskComment{}
+		if err := utils.ReadRequest(c, comment); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		comment.TaskID = c.GetInt64("task_id")
+		comment.AuthorID = c.MustGet("user").(*models.User).ID
+
+		comment, err := h.commentsUC.Create(ctx, c.GetInt64("project_id"), comment)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, comment)
+	}
+}
+
+// Update godoc
+// @Summary      Edit task comment
+// @Description  Edit the comment, only its author can do it. Project members and the owner newly mentioned as @<user_id> are notified
+// @Tags		 comments
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        comment_id path string true "comment id"
+// @Param		 commentBody body  models.TaskComment true "updates to the comment"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskComment
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/comments/{comment_id} [patch]
+func (h commentsHandlers) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "commentsHandlers.Update")
+		defer span.End()
+
+		updates := &models.TaskComment{}
+		if err := utils.ReadRequest(c, updates); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		updates.ID = c.GetInt64("comment_id")
+		updates.TaskID = c.GetInt64("task_id")
+
+		comment, err := h.commentsUC.Update(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"), updates)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, comment)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete task comment
+// @Description  Delete the comment. It's allowed to its author, project owner and admins
+// @Tags		 comments
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        comment_id path string true "comment id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/comments/{comment_id} [delete]
+func (h commentsHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "commentsHandlers.Delete")
+		defer span.End()
+
+		if err := h.commentsUC.Delete(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"),
+			c.GetInt64("task_id"), c.GetInt64("comment_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type dependenciesHandlers struct {
+	dependenciesUC projects.DependenciesUseCase
+	log            logger.Logger
+	tracer         trace.Tracer
+}
+
+func NewDependenciesHandlers(dependenciesUC projects.DependenciesUseCase, log logger.Logger) projects.DependencyHandlers {
+	return dependenciesHandlers{dependenciesUC: dependenciesUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get task blockers
+// @Description  Get tasks blocking the task, it can't be started until they are done
+// @Tags		 dependencies
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.DependencyTask
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/blockers [get]
+func (h dependenciesHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "dependenciesHandlers.Get")
+		defer span.End()
+
+		blockers, err := h.dependenciesUC.Get(ctx, c.GetInt64("task_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, blockers)
+	}
+}
+
+// Create godoc
+// @Summary      Add task blocker
+// @Description  Block the task by another task of the project. Dependencies can't make a cycle
+// @Tags		 dependencies
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 blockerBody body  http.AddTaskBlockerRequest true "blocking task"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/blockers [post]
+func (h dependenciesHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "dependenciesHandlers.Create")
+		defer span.End()
+
+		req := &AddTaskBlockerRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		if err := h.dependenciesUC.Create(ctx, c.GetInt64("project_id"), &models.TaskDependency{
+			TaskID:    c.GetInt64("task_id"),
+			BlockerID: req.BlockerID,
+		}); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// Delete godoc
+// @Summary      Remove task blocker
+// @Description  Remove the blocker of the task
+// @Tags		 dependencies
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        blocker_id path string true "blocking task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/blockers/{blocker_id} [delete]
+func (h dependenciesHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "dependenciesHandlers.Delete")
+		defer span.End()
+
+		if err := h.dependenciesUC.Delete(ctx, c.GetInt64("task_id"), c.GetInt64("blocker_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// GetGraph godoc
+// @Summary      Get project dependency graph
+// @Description  Get tasks of the project having blockers or blocking other tasks and dependencies between them
+// @Tags		 dependencies
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.DependencyGraph
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/dependencies [get]
+func (h dependenciesHandlers) GetGraph() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "dependenciesHandlers.GetGraph")
+		defer span.End()
+
+		graph, err := h.dependenciesUC.GetGraph(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, graph)
+	}
+}
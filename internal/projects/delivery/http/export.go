@@ -0,0 +1,107 @@
+package http
+
+import (
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/export"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const formatJSON = "json"
+
+// Columns of exported files are named as JSON fields of the same listing
+var (
+	timeEntryColumns = []string{"id", "task_id", "user_id", "started_at", "ended_at", "auto_stopped", "description",
+		"tag_ids", "billable", "invoice_id"}
+	productivityColumns = []string{"task_id", "tag_id", "spent_hours", "spent_minutes", "billable_seconds", "amount"}
+	reportRowColumns    = []string{"key1", "name1", "key2", "name2", "total_seconds", "billable_seconds"}
+	reportEntryColumns  = []string{"id", "project_id", "project_name", "task_id", "task_name", "user_id", "user_name",
+		"started_at", "ended_at", "seconds", "billable", "description", "tags"}
+)
+
+func timeEntryRecord(entry *models.TimeEntry) []interface{} {
+	tagIDs := make([]string, len(entry.TagIDs))
+	for i, tagID := range entry.TagIDs {
+		tagIDs[i] = strconv.FormatInt(tagID, 10)
+	}
+	return []interface{}{entry.ID, entry.TaskID, entry.UserID, entry.StartedAt, entry.EndedAt, entry.AutoStopped,
+		entry.Description, strings.Join(tagIDs, ","), entry.Billable, entry.InvoiceID}
+}
+
+func productivityRecord(productivity models.UserProductivity) []interface{} {
+	return []interface{}{productivity.TaskID, productivity.TagID, productivity.SpentHours, productivity.SpentMinutes,
+		productivity.BillableSeconds, productivity.Amount}
+}
+
+func reportRowRecord(row *models.ReportRow) []interface{} {
+	return []interface{}{row.Key1, row.Name1, row.Key2, row.Name2, row.TotalSeconds, row.BillableSeconds}
+}
+
+func reportEntryRecord(entry *models.ReportEntry) []interface{} {
+	return []interface{}{entry.ID, entry.ProjectID, entry.ProjectName, entry.TaskID, entry.TaskName, entry.UserID,
+		entry.UserName, entry.StartedAt, entry.EndedAt, entry.Seconds, entry.Billable, entry.Description,
+		strings.Join(entry.Tags, ",")}
+}
+
+// exportFormat selects format of the listing by format query parameter or Accept header, JSON by default
+func exportFormat(c *gin.Context) (string, error) {
+	switch format := c.Query("format"); format {
+	case formatJSON, export.FormatCSV, export.FormatXLSX:
+		return format, nil
+	case "":
+	default:
+		return "", httpErrors.NewRestError(http.StatusBadRequest, httpErrors.BadQueryParams.Error(),
+			"format is one of json, csv, xlsx")
+	}
+
+	switch c.NegotiateFormat(gin.MIMEJSON, export.ContentTypeCSV, export.ContentTypeXLSX) {
+	case export.ContentTypeCSV:
+		return export.FormatCSV, nil
+	case export.ContentTypeXLSX:
+		return export.FormatXLSX, nil
+	}
+	return formatJSON, nil
+}
+
+// exportResponse sets file headers on the first write, so errors before it are still sent as JSON
+type exportResponse struct {
+	c        *gin.Context
+	format   string
+	filename string
+}
+
+func (r exportResponse) Write(p []byte) (int, error) {
+	if !r.c.Writer.Written() {
+		r.c.Header("Content-Type", export.ContentType(r.format))
+		r.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, r.filename, r.format))
+		r.c.Status(http.StatusOK)
+	}
+	return r.c.Writer.Write(p)
+}
+
+// writeExport writes records passed by stream to the response as file of the format.
+// Errors after the first written row can't be sent to the client, so the response is aborted
+func writeExport(c *gin.Context, log logger.Logger, format, filename string, columns []string,
+	stream func(w export.Writer) error) {
+	w := export.NewWriter(format, exportResponse{c: c, format: format, filename: filename}, columns)
+	err := stream(w)
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		return
+	}
+
+	utils.LogResponseError(c, log, err)
+	if c.Writer.Written() {
+		c.Abort()
+		return
+	}
+	c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+}
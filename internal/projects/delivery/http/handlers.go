@@ -2,9 +2,11 @@ package http
 
 import (
 	"context"
+	"fmt"
 	"github.com/armanokka/time_tracker/config"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/export"
 	"github.com/armanokka/time_tracker/pkg/httpErrors"
 	"github.com/armanokka/time_tracker/pkg/logger"
 	"github.com/armanokka/time_tracker/pkg/utils"
@@ -264,8 +266,14 @@ func (h projectHandlers) GetMembers() gin.HandlerFunc {
 // @Description  Get project member productivity
 // @Tags		 projects
 // @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 // @Param        project_id path string true "project id"
 // @Param        user_id path string true "project id"
+// @Param        tag_id query int false "count only entries with the tag"
+// @Param        group_by query string false "task or tag, task by default"
+// @Param        approved query bool false "count only entries of approved timesheets"
+// @Param        format query string false "json, csv or xlsx, selected by Accept header if omitted"
 // @Param        X-Access-Token header string true "Token that you get after authorization/registration"
 // @Success      200  {object}  models.UserProductivity
 // @Failure      400  {object}  httpErrors.RestError
@@ -279,12 +287,39 @@ func (h projectHandlers) GetMemberProductivity() gin.HandlerFunc {
 		projectID := c.GetInt64("project_id")
 		userID := c.GetInt64("user_id")
 
-		productivity, err := h.projectsUC.GetMemberProductivity(ctx, projectID, userID)
+		query := &utils.ProductivityQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		format, err := exportFormat(c)
 		if err != nil {
 			utils.LogResponseError(c, h.log, err)
 			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
 			return
 		}
+
+		productivity, err := h.projectsUC.GetMemberProductivity(ctx, projectID, userID, query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		if format != formatJSON {
+			// Productivity is aggregated per task or tag, so it is small enough to be exported from memory
+			writeExport(c, h.log, format, fmt.Sprintf("project-%d-user-%d-productivity", projectID, userID),
+				productivityColumns, func(w export.Writer) error {
+					for _, row := range productivity {
+						if err := w.Write(productivityRecord(row)); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			return
+		}
 		c.JSON(200, productivity)
 	}
 }
@@ -0,0 +1,241 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+type invoicesHandlers struct {
+	invoicesUC projects.InvoicesUseCase
+	log        logger.Logger
+	tracer     trace.Tracer
+}
+
+func NewInvoicesHandlers(invoicesUC projects.InvoicesUseCase, log logger.Logger) projects.InvoiceHandlers {
+	return invoicesHandlers{invoicesUC: invoicesUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project invoices
+// @Description  Get project invoices without line items, newest first
+// @Tags		 invoices
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Invoice
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices [get]
+func (h invoicesHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.Get")
+		defer span.End()
+
+		invoices, err := h.invoicesUC.Get(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, invoices)
+	}
+}
+
+// GetByID godoc
+// @Summary      Get project invoice
+// @Description  Get project invoice with line items
+// @Tags		 invoices
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        invoice_id path string true "invoice id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Invoice
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices/{invoice_id} [get]
+func (h invoicesHandlers) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.GetByID")
+		defer span.End()
+
+		invoice, err := h.invoicesUC.GetByID(ctx, c.GetInt64("project_id"), c.GetInt64("invoice_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, invoice)
+	}
+}
+
+// Download godoc
+// @Summary      Download project invoice
+// @Description  Download project invoice as PDF or JSON file
+// @Tags		 invoices
+// @Produce      application/pdf
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        invoice_id path string true "invoice id"
+// @Param        format query string false "pdf or json, pdf by default"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {file}  file
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices/{invoice_id}/download [get]
+func (h invoicesHandlers) Download() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.Download")
+		defer span.End()
+
+		query := &DownloadInvoiceQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		projectID, invoiceID := c.GetInt64("project_id"), c.GetInt64("invoice_id")
+
+		if query.Format == "json" {
+			invoice, err := h.invoicesUC.GetByID(ctx, projectID, invoiceID)
+			if err != nil {
+				utils.LogResponseError(c, h.log, err)
+				c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+				return
+			}
+			c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="invoice-%d.json"`, invoiceID))
+			c.IndentedJSON(200, invoice)
+			return
+		}
+
+		file, err := h.invoicesUC.GetPDF(ctx, projectID, invoiceID)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="invoice-%d.pdf"`, invoiceID))
+		c.Data(200, "application/pdf", file)
+	}
+}
+
+// Create godoc
+// @Summary      Create project invoice
+// @Description  Create draft invoice from uninvoiced billable entries of the project started in the period. Invoiced entries can't be changed
+// @Tags		 invoices
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 invoiceBody body  http.CreateInvoiceRequest true "period, grouping of line items and tax rate in percents"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Invoice
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices [post]
+func (h invoicesHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.Create")
+		defer span.End()
+
+		req := &CreateInvoiceRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		// Dates are validated by ReadRequest
+		from, _ := time.Parse(time.DateOnly, req.From)
+		to, _ := time.Parse(time.DateOnly, req.To)
+
+		invoice, err := h.invoicesUC.Create(ctx, &models.Invoice{
+			ProjectID:  c.GetInt64("project_id"),
+			CreatedBy:  c.MustGet("user").(*models.User).ID,
+			PeriodFrom: from,
+			PeriodTo:   to,
+			GroupBy:    req.GroupBy,
+			TaxRate:    req.TaxRate,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, invoice)
+	}
+}
+
+// UpdateStatus godoc
+// @Summary      Update project invoice status
+// @Description  Mark draft invoice as sent or sent invoice as paid
+// @Tags		 invoices
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        invoice_id path string true "invoice id"
+// @Param		 statusBody body  http.UpdateInvoiceStatusRequest true "new status"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Invoice
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices/{invoice_id}/status [put]
+func (h invoicesHandlers) UpdateStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.UpdateStatus")
+		defer span.End()
+
+		req := &UpdateInvoiceStatusRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		invoice, err := h.invoicesUC.UpdateStatus(ctx, c.GetInt64("project_id"), c.GetInt64("invoice_id"), req.Status)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, invoice)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete project invoice
+// @Description  Delete draft invoice, its entries can be invoiced again
+// @Tags		 invoices
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        invoice_id path string true "invoice id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/invoices/{invoice_id} [delete]
+func (h invoicesHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "invoicesHandlers.Delete")
+		defer span.End()
+
+		if err := h.invoicesUC.Delete(ctx, c.GetInt64("project_id"), c.GetInt64("invoice_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
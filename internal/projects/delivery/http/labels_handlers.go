@@ -0,0 +1,219 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type labelsHandlers struct {
+	labelsUC projects.LabelsUseCase
+	log      logger.Logger
+	tracer   trace.Tracer
+}
+
+func NewLabelsHandlers(labelsUC projects.LabelsUseCase, log logger.Logger) projects.LabelHandlers {
+	return labelsHandlers{labelsUC: labelsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project labels
+// @Description  Get labels of the project tasks, sorted by name
+// @Tags		 labels
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Label
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/labels [get]
+func (h labelsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Get")
+		defer span.End()
+
+		labels, err := h.labelsUC.Get(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, labels)
+	}
+}
+
+// Create godoc
+// @Summary      Create project label
+// @Description  Create project label. Label names are unique within the project, color is gray by default
+// @Tags		 labels
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 labelBody body  models.Label true "label to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Label
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/labels [post]
+func (h labelsHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Create")
+		defer span.End()
+
+		label := &models.Label{}
+		if err := utils.ReadRequest(c, label); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		label.ProjectID = c.GetInt64("project_id")
+
+		label, err := h.labelsUC.Create(ctx, label)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, label)
+	}
+}
+
+// Update godoc
+// @Summary      Update project label
+// @Description  Rename project label or change its color, color is kept if omitted
+// @Tags		 labels
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        label_id path string true "label id"
+// @Param		 labelBody body  models.Label true "updates to the label"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Label
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/labels/{label_id} [patch]
+func (h labelsHandlers) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Update")
+		defer span.End()
+
+		label := &models.Label{}
+		if err := utils.ReadRequest(c, label); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		label.ID = c.GetInt64("label_id")
+		label.ProjectID = c.GetInt64("project_id")
+
+		label, err := h.labelsUC.Update(ctx, label)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, label)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete project label
+// @Description  Delete project label, it is removed from all tasks
+// @Tags		 labels
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        label_id path string true "label id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/labels/{label_id} [delete]
+func (h labelsHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Delete")
+		defer span.End()
+
+		if err := h.labelsUC.Delete(ctx, c.GetInt64("project_id"), c.GetInt64("label_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// Attach godoc
+// @Summary      Label task
+// @Description  Attach the label of the project to the task
+// @Tags		 labels
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 labelBody body  http.AttachTaskLabelRequest true "label to be attached"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/labels [post]
+func (h labelsHandlers) Attach() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Attach")
+		defer span.End()
+
+		req := &AttachTaskLabelRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		if err := h.labelsUC.Attach(ctx, c.GetInt64("project_id"), c.GetInt64("task_id"), req.LabelID); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// Detach godoc
+// @Summary      Unlabel task
+// @Description  Detach the label from the task
+// @Tags		 labels
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        label_id path string true "label id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/labels/{label_id} [delete]
+func (h labelsHandlers) Detach() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "labelsHandlers.Detach")
+		defer span.End()
+
+		if err := h.labelsUC.Detach(ctx, c.GetInt64("project_id"), c.GetInt64("task_id"),
+			c.GetInt64("label_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
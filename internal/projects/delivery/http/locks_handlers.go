@@ -0,0 +1,198 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type locksHandlers struct {
+	locksUC projects.LocksUseCase
+	log     logger.Logger
+	tracer  trace.Tracer
+}
+
+func NewLocksHandlers(locksUC projects.LocksUseCase, log logger.Logger) projects.LockHandlers {
+	return locksHandlers{locksUC: locksUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get period locks
+// @Description  Get global lock and locks of all projects. Admins only
+// @Tags		 locks
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.PeriodLock
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /locks [get]
+func (h locksHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.Get")
+		defer span.End()
+
+		locks, err := h.locksUC.Get(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, locks)
+	}
+}
+
+// SetGlobal godoc
+// @Summary      Set global period lock
+// @Description  Lock entries of all projects started on the date or earlier. Admins only
+// @Tags		 locks
+// @Accept       json
+// @Produce      json
+// @Param		 lockBody body  http.SetLockRequest true "last locked date"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.PeriodLock
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /locks [put]
+func (h locksHandlers) SetGlobal() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.SetGlobal")
+		defer span.End()
+
+		h.set(ctx, c, nil)
+	}
+}
+
+// DeleteGlobal godoc
+// @Summary      Delete global period lock
+// @Description  Delete global period lock, project locks are kept. Admins only
+// @Tags		 locks
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /locks [delete]
+func (h locksHandlers) DeleteGlobal() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.DeleteGlobal")
+		defer span.End()
+
+		if err := h.locksUC.Delete(ctx, 0); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// GetProjectLock godoc
+// @Summary      Get period lock of the project
+// @Description  Get period lock of the project. Global lock is applied too if it is later
+// @Tags		 locks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.PeriodLock
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/lock [get]
+func (h locksHandlers) GetProjectLock() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.GetProjectLock")
+		defer span.End()
+
+		lock, err := h.locksUC.GetByProject(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, lock)
+	}
+}
+
+// SetProjectLock godoc
+// @Summary      Set period lock of the project
+// @Description  Lock entries of the project started on the date or earlier. Admins only
+// @Tags		 locks
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 lockBody body  http.SetLockRequest true "last locked date"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.PeriodLock
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/lock [put]
+func (h locksHandlers) SetProjectLock() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.SetProjectLock")
+		defer span.End()
+
+		projectID := c.GetInt64("project_id")
+		h.set(ctx, c, &projectID)
+	}
+}
+
+// DeleteProjectLock godoc
+// @Summary      Delete period lock of the project
+// @Description  Delete period lock of the project. Admins only
+// @Tags		 locks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/lock [delete]
+func (h locksHandlers) DeleteProjectLock() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "locksHandlers.DeleteProjectLock")
+		defer span.End()
+
+		if err := h.locksUC.Delete(ctx, c.GetInt64("project_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// set reads the lock date and sets the lock of the project, global lock if projectID is nil
+func (h locksHandlers) set(ctx context.Context, c *gin.Context, projectID *int64) {
+	req := &SetLockRequest{}
+	if err := utils.ReadRequest(c, req); err != nil {
+		utils.LogResponseError(c, h.log, err)
+		c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+		return
+	}
+
+	user := c.MustGet("user").(*models.User)
+	lock, err := h.locksUC.Set(ctx, &models.PeriodLock{
+		ProjectID:   projectID,
+		LockedUntil: req.LockedUntil,
+		UpdatedBy:   &user.ID,
+	})
+	if err != nil {
+		utils.LogResponseError(c, h.log, err)
+		c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+		return
+	}
+	c.JSON(200, lock)
+}
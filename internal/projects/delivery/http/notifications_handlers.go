@@ -0,0 +1,111 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type notificationsHandlers struct {
+	notificationsUC projects.NotificationsUseCase
+	log             logger.Logger
+	tracer          trace.Tracer
+}
+
+func NewNotificationsHandlers(notificationsUC projects.NotificationsUseCase, log logger.Logger) projects.NotificationHandlers {
+	return notificationsHandlers{notificationsUC: notificationsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get notifications
+// @Description  Get notifications of the current user, newest first
+// @Tags		 notifications
+// @Produce      json
+// @Param        unread query bool false "unread notifications only"
+// @Param        limit query int false "number of notifications, 50 by default"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Notification
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/notifications [get]
+func (h notificationsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "notificationsHandlers.Get")
+		defer span.End()
+
+		query := &utils.NotificationsQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		user := c.MustGet("user").(*models.User)
+		notifications, err := h.notificationsUC.Get(ctx, user.ID, query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, notifications)
+	}
+}
+
+// Read godoc
+// @Summary      Read notification
+// @Description  Mark notification of the current user as read
+// @Tags		 notifications
+// @Produce      json
+// @Param        notification_id path string true "notification id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Notification
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/notifications/{notification_id}/read [put]
+func (h notificationsHandlers) Read() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "notificationsHandlers.Read")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+		notification, err := h.notificationsUC.Read(ctx, user.ID, c.GetInt64("notification_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, notification)
+	}
+}
+
+// ReadAll godoc
+// @Summary      Read all notifications
+// @Description  Mark all notifications of the current user as read
+// @Tags		 notifications
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/notifications/read [put]
+func (h notificationsHandlers) ReadAll() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "notificationsHandlers.ReadAll")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+		if _, err := h.notificationsUC.ReadAll(ctx, user.ID); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
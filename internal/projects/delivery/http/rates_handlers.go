@@ -0,0 +1,214 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ratesHandlers struct {
+	ratesUC projects.RatesUseCase
+	log     logger.Logger
+	tracer  trace.Tracer
+}
+
+func NewRatesHandlers(ratesUC projects.RatesUseCase, log logger.Logger) projects.RateHandlers {
+	return ratesHandlers{ratesUC: ratesUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// GetUserRates godoc
+// @Summary      Get workspace hourly rates of the user
+// @Description  Get workspace hourly rates of the user, newest first. Admins only
+// @Tags		 rates
+// @Produce      json
+// @Param        user_id path string true "user id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.HourlyRate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/{user_id}/rates [get]
+func (h ratesHandlers) GetUserRates() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.GetUserRates")
+		defer span.End()
+
+		rates, err := h.ratesUC.GetUserRates(ctx, c.GetInt64("user_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, rates)
+	}
+}
+
+// CreateUserRate godoc
+// @Summary      Create workspace hourly rate of the user
+// @Description  Create workspace hourly rate of the user effective from the date. Admins only
+// @Tags		 rates
+// @Accept       json
+// @Produce      json
+// @Param        user_id path string true "user id"
+// @Param		 rateBody body  http.CreateUserRateRequest true "rate to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.HourlyRate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/{user_id}/rates [post]
+func (h ratesHandlers) CreateUserRate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.CreateUserRate")
+		defer span.End()
+
+		req := &CreateUserRateRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		userID := c.GetInt64("user_id")
+		rate, err := h.ratesUC.Create(ctx, &models.HourlyRate{
+			UserID:        &userID,
+			Rate:          req.Rate,
+			EffectiveFrom: req.EffectiveFrom,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, rate)
+	}
+}
+
+// DeleteUserRate godoc
+// @Summary      Delete workspace hourly rate of the user
+// @Description  Delete workspace hourly rate of the user. Admins only
+// @Tags		 rates
+// @Produce      json
+// @Param        user_id path string true "user id"
+// @Param        rate_id path string true "rate id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/{user_id}/rates/{rate_id} [delete]
+func (h ratesHandlers) DeleteUserRate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.DeleteUserRate")
+		defer span.End()
+
+		if err := h.ratesUC.Delete(ctx, 0, c.GetInt64("rate_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// GetProjectRates godoc
+// @Summary      Get hourly rates of the project
+// @Description  Get hourly rates of the project and its members
+// @Tags		 rates
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.HourlyRate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/rates [get]
+func (h ratesHandlers) GetProjectRates() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.GetProjectRates")
+		defer span.End()
+
+		rates, err := h.ratesUC.GetProjectRates(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, rates)
+	}
+}
+
+// CreateProjectRate godoc
+// @Summary      Create hourly rate of the project
+// @Description  Create hourly rate of the project or its member effective from the date
+// @Tags		 rates
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 rateBody body  http.CreateProjectRateRequest true "rate to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.HourlyRate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/rates [post]
+func (h ratesHandlers) CreateProjectRate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.CreateProjectRate")
+		defer span.End()
+
+		req := &CreateProjectRateRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		projectID := c.GetInt64("project_id")
+		rate, err := h.ratesUC.Create(ctx, &models.HourlyRate{
+			UserID:        req.UserID,
+			ProjectID:     &projectID,
+			Rate:          req.Rate,
+			EffectiveFrom: req.EffectiveFrom,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, rate)
+	}
+}
+
+// DeleteProjectRate godoc
+// @Summary      Delete hourly rate of the project
+// @Description  Delete hourly rate of the project or its member
+// @Tags		 rates
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        rate_id path string true "rate id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/rates/{rate_id} [delete]
+func (h ratesHandlers) DeleteProjectRate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "ratesHandlers.DeleteProjectRate")
+		defer span.End()
+
+		if err := h.ratesUC.Delete(ctx, c.GetInt64("project_id"), c.GetInt64("rate_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
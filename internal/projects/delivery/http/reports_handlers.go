@@ -0,0 +1,147 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/export"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type reportsHandlers struct {
+	reportsUC projects.ReportsUseCase
+	log       logger.Logger
+	tracer    trace.Tracer
+}
+
+func NewReportsHandlers(reportsUC projects.ReportsUseCase, log logger.Logger) projects.ReportHandlers {
+	return reportsHandlers{reportsUC: reportsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get time report
+// @Description  Get tracked seconds of entries started in the date range grouped by one or two dimensions with subtotals. Admins see all entries, project owners see entries of their projects, members see their own entries. CSV and XLSX contain flat rows without subtotals
+// @Tags		 reports
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        from query string true "first date, YYYY-MM-DD"
+// @Param        to query string true "last date, YYYY-MM-DD"
+// @Param        group_by query []string true "one or two of project, task, user, day, week, month, label. Time of the task with several labels is split evenly between them" collectionFormat(multi)
+// @Param        project_id query []int false "filter by projects" collectionFormat(multi)
+// @Param        task_id query []int false "filter by tasks" collectionFormat(multi)
+// @Param        user_id query []int false "filter by users" collectionFormat(multi)
+// @Param        tag_id query []int false "filter by entries with any of the tags" collectionFormat(multi)
+// @Param        billable query bool false "filter by billable flag"
+// @Param        timezone query string false "timezone of day, week and month boundaries, UTC by default"
+// @Param        rollup query bool false "report time of subtasks as time of their top-level task"
+// @Param        approved query bool false "report time of approved timesheets only"
+// @Param        format query string false "json, csv or xlsx, selected by Accept header if omitted"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Report
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /reports [get]
+func (h reportsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "reportsHandlers.Get")
+		defer span.End()
+
+		query := &utils.ReportQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		format, err := exportFormat(c)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		user := c.MustGet("user").(*models.User)
+
+		if format != formatJSON {
+			writeExport(c, h.log, format, "report", reportRowColumns, func(w export.Writer) error {
+				return h.reportsUC.Export(ctx, user, query, func(row *models.ReportRow) error {
+					return w.Write(reportRowRecord(row))
+				})
+			})
+			return
+		}
+
+		report, err := h.reportsUC.Get(ctx, user, query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, report)
+	}
+}
+
+// GetEntries godoc
+// @Summary      Get report entries
+// @Description  Get raw entries started in the date range with names of their projects, tasks, users and tags, oldest first. Permissions are the same as in the report
+// @Tags		 reports
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        from query string true "first date, YYYY-MM-DD"
+// @Param        to query string true "last date, YYYY-MM-DD"
+// @Param        project_id query []int false "filter by projects" collectionFormat(multi)
+// @Param        task_id query []int false "filter by tasks" collectionFormat(multi)
+// @Param        user_id query []int false "filter by users" collectionFormat(multi)
+// @Param        tag_id query []int false "filter by entries with any of the tags" collectionFormat(multi)
+// @Param        billable query bool false "filter by billable flag"
+// @Param        timezone query string false "timezone of date boundaries, UTC by default"
+// @Param        rollup query bool false "report entries of subtasks as entries of their top-level task"
+// @Param        approved query bool false "report entries of approved timesheets only"
+// @Param        format query string false "json, csv or xlsx, selected by Accept header if omitted"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.ReportEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /reports/entries [get]
+func (h reportsHandlers) GetEntries() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "reportsHandlers.GetEntries")
+		defer span.End()
+
+		filter := &utils.ReportFilter{}
+		if err := utils.ReadRequest(c, filter); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		format, err := exportFormat(c)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		user := c.MustGet("user").(*models.User)
+
+		if format != formatJSON {
+			writeExport(c, h.log, format, "entries", reportEntryColumns, func(w export.Writer) error {
+				return h.reportsUC.ExportEntries(ctx, user, filter, func(entry *models.ReportEntry) error {
+					return w.Write(reportEntryRecord(entry))
+				})
+			})
+			return
+		}
+
+		entries, err := h.reportsUC.GetEntries(ctx, user, filter)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, entries)
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func MapProjectsTasksRoutes(projectsGroup *gin.RouterGroup, project projects.Handlers, task projects.TaskHandlers, mw middleware.Manager) {
+func MapProjectsTasksRoutes(projectsGroup *gin.RouterGroup, project projects.Handlers, task projects.TaskHandlers,
+	entry projects.TimeEntryHandlers, tag projects.TagHandlers, rate projects.RateHandlers, invoice projects.InvoiceHandlers,
+	timesheet projects.TimesheetHandlers, lock projects.LockHandlers, budget projects.BudgetHandlers,
+	status projects.StatusHandlers, dependency projects.DependencyHandlers, comment projects.CommentHandlers,
+	attachment projects.AttachmentHandlers, label projects.LabelHandlers, mw middleware.Manager) {
 	projectsGroup.Use(mw.AuthJWTMiddleware(), mw.ParsePathParametersMiddleware())
 	projectsGroup.POST("/", project.Create())
 	projectsGroup.GET("/:project_id", mw.OwnerOrAdminMiddleware(), project.GetByID())
@@ -18,19 +22,140 @@ func MapProjectsTasksRoutes(projectsGroup *gin.RouterGroup, project projects.Han
 	projectsGroup.GET("/:project_id/users/:user_id", mw.OwnerOrAdminMiddleware(), project.GetMemberProductivity())
 	projectsGroup.DELETE("/:project_id/users/:user_id", mw.MemberOrOwnerOrAdminMiddleware(), project.RemoveMember())
 
+	projectsGroup.GET("/:project_id/tags", mw.MemberOrOwnerOrAdminMiddleware(), tag.Get())
+	projectsGroup.POST("/:project_id/tags", mw.OwnerOrAdminMiddleware(), tag.Create())
+	projectsGroup.PATCH("/:project_id/tags/:tag_id", mw.OwnerOrAdminMiddleware(), tag.Update())
+	projectsGroup.DELETE("/:project_id/tags/:tag_id", mw.OwnerOrAdminMiddleware(), tag.Delete())
+
+	projectsGroup.GET("/:project_id/labels", mw.MemberOrOwnerOrAdminMiddleware(), label.Get())
+	projectsGroup.POST("/:project_id/labels", mw.OwnerOrAdminMiddleware(), label.Create())
+	projectsGroup.PATCH("/:project_id/labels/:label_id", mw.OwnerOrAdminMiddleware(), label.Update())
+	projectsGroup.DELETE("/:project_id/labels/:label_id", mw.OwnerOrAdminMiddleware(), label.Delete())
+
+	projectsGroup.GET("/:project_id/statuses", mw.MemberOrOwnerOrAdminMiddleware(), status.Get())
+	projectsGroup.POST("/:project_id/statuses", mw.OwnerOrAdminMiddleware(), status.Create())
+	projectsGroup.PATCH("/:project_id/statuses/:status_id", mw.OwnerOrAdminMiddleware(), status.Update())
+	projectsGroup.DELETE("/:project_id/statuses/:status_id", mw.OwnerOrAdminMiddleware(), status.Delete())
+
+	projectsGroup.GET("/:project_id/rates", mw.OwnerOrAdminMiddleware(), rate.GetProjectRates())
+	projectsGroup.POST("/:project_id/rates", mw.OwnerOrAdminMiddleware(), rate.CreateProjectRate())
+	projectsGroup.DELETE("/:project_id/rates/:rate_id", mw.OwnerOrAdminMiddleware(), rate.DeleteProjectRate())
+
+	projectsGroup.GET("/:project_id/lock", mw.OwnerOrAdminMiddleware(), lock.GetProjectLock())
+	projectsGroup.PUT("/:project_id/lock", mw.AdminMiddleware(), lock.SetProjectLock())
+	projectsGroup.DELETE("/:project_id/lock", mw.AdminMiddleware(), lock.DeleteProjectLock())
+
+	projectsGroup.GET("/:project_id/estimates", mw.MemberOrOwnerOrAdminMiddleware(), task.GetEstimates())
+	projectsGroup.GET("/:project_id/estimates/over", mw.MemberOrOwnerOrAdminMiddleware(), task.GetOverEstimate())
+
+	projectsGroup.GET("/:project_id/dependencies", mw.MemberOrOwnerOrAdminMiddleware(), dependency.GetGraph())
+
+	projectsGroup.GET("/:project_id/budget", mw.OwnerOrAdminMiddleware(), budget.Get())
+	projectsGroup.PUT("/:project_id/budget", mw.OwnerOrAdminMiddleware(), budget.Set())
+	projectsGroup.DELETE("/:project_id/budget", mw.OwnerOrAdminMiddleware(), budget.Delete())
+	projectsGroup.GET("/:project_id/budget/consumption", mw.MemberOrOwnerOrAdminMiddleware(), budget.GetConsumption())
+
+	invoicesGroup := projectsGroup.Group("/:project_id/invoices")
+	invoicesGroup.Use(mw.OwnerOrAdminMiddleware())
+	invoicesGroup.GET("/", invoice.Get())
+	invoicesGroup.POST("/", invoice.Create())
+	invoicesGroup.GET("/:invoice_id", invoice.GetByID())
+	invoicesGroup.GET("/:invoice_id/download", invoice.Download())
+	invoicesGroup.PUT("/:invoice_id/status", invoice.UpdateStatus())
+	invoicesGroup.DELETE("/:invoice_id", invoice.Delete())
+
+	projectsGroup.GET("/:project_id/timesheets", mw.OwnerOrAdminMiddleware(), timesheet.Get())
+	projectsGroup.POST("/:project_id/timesheets", mw.MemberOrOwnerOrAdminMiddleware(), timesheet.Submit())
+	projectsGroup.PUT("/:project_id/timesheets/:timesheet_id/approve", mw.OwnerOrAdminMiddleware(), timesheet.Approve())
+	projectsGroup.PUT("/:project_id/timesheets/:timesheet_id/reject", mw.OwnerOrAdminMiddleware(), timesheet.Reject())
+
 	tasksGroup := projectsGroup.Group("/:project_id/tasks")
 	tasksGroup.Use(mw.MemberOrOwnerOrAdminMiddleware())
 
 	tasksGroup.GET("/", task.Get())
 	tasksGroup.POST("/", task.Create())
-	//tasksGroup.GET("/:task_id", task.GetByID())
+	tasksGroup.POST("/bulk", task.Bulk())
+	tasksGroup.GET("/:task_id", task.GetByID())
 	tasksGroup.PATCH("/:task_id", task.Update())
+	tasksGroup.POST("/:task_id/move", task.Move())
 	tasksGroup.DELETE("/:task_id", task.Delete())
 
 	tasksGroup.POST("/:task_id/start", task.Start())
 	tasksGroup.POST("/:task_id/stop", task.Stop())
+	tasksGroup.POST("/:task_id/pause", task.Pause())
+	tasksGroup.POST("/:task_id/resume", task.Resume())
+
+	tasksGroup.GET("/:task_id/blockers", dependency.Get())
+	tasksGroup.POST("/:task_id/blockers", dependency.Create())
+	tasksGroup.DELETE("/:task_id/blockers/:blocker_id", dependency.Delete())
+
+	tasksGroup.GET("/:task_id/comments", comment.Get())
+	tasksGroup.POST("/:task_id/comments", comment.Create())
+	tasksGroup.PATCH("/:task_id/comments/:comment_id", comment.Update())
+	tasksGroup.DELETE("/:task_id/comments/:comment_id", comment.Delete())
+
+	tasksGroup.GET("/:task_id/attachments", attachment.Get())
+	tasksGroup.POST("/:task_id/attachments", attachment.Upload())
+	tasksGroup.GET("/:task_id/attachments/:attachment_id", attachment.Download())
+	tasksGroup.DELETE("/:task_id/attachments/:attachment_id", attachment.Delete())
+
+	tasksGroup.POST("/:task_id/labels", label.Attach())
+	tasksGroup.DELETE("/:task_id/labels/:label_id", label.Detach())
 
 	tasksGroup.GET("/:task_id/users", task.GetMembers())
 	tasksGroup.POST("/:task_id/users", mw.OwnerOrAdminMiddleware(), task.AddMember())
 	tasksGroup.DELETE("/:task_id/users/:user_id", mw.OwnerOrAdminMiddleware(), task.DeleteMember())
+
+	tasksGroup.GET("/:task_id/entries", entry.Get())
+	tasksGroup.POST("/:task_id/entries", entry.Create())
+	tasksGroup.GET("/:task_id/entries/:entry_id", entry.GetByID())
+	tasksGroup.PATCH("/:task_id/entries/:entry_id", entry.Update())
+	tasksGroup.DELETE("/:task_id/entries/:entry_id", entry.Delete())
+}
+
+func MapTimerRoutes(meGroup *gin.RouterGroup, timerGroup *gin.RouterGroup, entry projects.TimeEntryHandlers, mw middleware.Manager) {
+	meGroup.Use(mw.AuthJWTMiddleware())
+	meGroup.GET("/timer", entry.GetActive())
+	meGroup.GET("/timer/settings", entry.GetSettings())
+	meGroup.PUT("/timer/settings", entry.UpdateSettings())
+
+	timerGroup.Use(mw.AuthJWTMiddleware())
+	timerGroup.POST("/switch", entry.Switch())
+}
+
+func MapUserTasksRoutes(meGroup *gin.RouterGroup, task projects.TaskHandlers, mw middleware.Manager) {
+	meGroup.Use(mw.AuthJWTMiddleware())
+	meGroup.GET("/tasks/due", task.GetDue())
+}
+
+func MapRatesRoutes(ratesGroup *gin.RouterGroup, rate projects.RateHandlers, mw middleware.Manager) {
+	ratesGroup.Use(mw.AuthJWTMiddleware(), mw.ParsePathParametersMiddleware(), mw.AdminMiddleware())
+	ratesGroup.GET("", rate.GetUserRates())
+	ratesGroup.POST("", rate.CreateUserRate())
+	ratesGroup.DELETE("/:rate_id", rate.DeleteUserRate())
+}
+
+func MapTimesheetsRoutes(timesheetsGroup *gin.RouterGroup, timesheet projects.TimesheetHandlers, mw middleware.Manager) {
+	timesheetsGroup.Use(mw.AuthJWTMiddleware())
+	timesheetsGroup.GET("/pending", timesheet.GetPending())
+}
+
+func MapLocksRoutes(locksGroup *gin.RouterGroup, lock projects.LockHandlers, mw middleware.Manager) {
+	locksGroup.Use(mw.AuthJWTMiddleware(), mw.AdminMiddleware())
+	locksGroup.GET("", lock.Get())
+	locksGroup.PUT("", lock.SetGlobal())
+	locksGroup.DELETE("", lock.DeleteGlobal())
+}
+
+func MapReportsRoutes(reportsGroup *gin.RouterGroup, report projects.ReportHandlers, mw middleware.Manager) {
+	reportsGroup.Use(mw.AuthJWTMiddleware())
+	reportsGroup.GET("", report.Get())
+	reportsGroup.GET("/entries", report.GetEntries())
+}
+
+func MapNotificationsRoutes(notificationsGroup *gin.RouterGroup, notification projects.NotificationHandlers, mw middleware.Manager) {
+	notificationsGroup.Use(mw.AuthJWTMiddleware(), mw.ParsePathParametersMiddleware())
+	notificationsGroup.GET("", notification.Get())
+	notificationsGroup.PUT("/read", notification.ReadAll())
+	notificationsGroup.PUT("/:notification_id/read", notification.Read())
 }
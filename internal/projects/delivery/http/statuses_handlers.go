@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type statusesHandlers struct {
+	statusesUC projects.StatusesUseCase
+	log        logger.Logger
+	tracer     trace.Tracer
+}
+
+func NewStatusesHandlers(statusesUC projects.StatusesUseCase, log logger.Logger) projects.StatusHandlers {
+	return statusesHandlers{statusesUC: statusesUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project task statuses
+// @Description  Get workflow statuses of the project tasks with allowed transitions in workflow order
+// @Tags		 statuses
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.TaskStatus
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/statuses [get]
+func (h statusesHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "statusesHandlers.Get")
+		defer span.End()
+
+		statuses, err := h.statusesUC.Get(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, statuses)
+	}
+}
+
+// Create godoc
+// @Summary      Create project task status
+// @Description  Create workflow status of the project tasks
+// @Tags		 statuses
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 statusBody body  http.CreateTaskStatusRequest true "status to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskStatus
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/statuses [post]
+func (h statusesHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "statusesHandlers.Create")
+		defer span.End()
+
+		req := &CreateTaskStatusRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		status, err := h.statusesUC.Create(ctx, &models.TaskStatus{
+			ProjectID:   c.GetInt64("project_id"),
+			Name:        req.Name,
+			Category:    req.Category,
+			Transitions: req.Transitions,
+		}, req.Position)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, status)
+	}
+}
+
+// Update godoc
+// @Summary      Update project task status
+// @Description  Update workflow status of the project tasks. Null transitions are kept, empty transitions allow moving to any status
+// @Tags		 statuses
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        status_id path string true "status id"
+// @Param		 statusBody body  http.UpdateTaskStatusRequest true "updates to the status"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskStatus
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/statuses/{status_id} [patch]
+func (h statusesHandlers) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "statusesHandlers.Update")
+		defer span.End()
+
+		req := &UpdateTaskStatusRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		status, err := h.statusesUC.Update(ctx, &models.TaskStatus{
+			ID:          c.GetInt64("status_id"),
+			ProjectID:   c.GetInt64("project_id"),
+			Name:        req.Name,
+			Category:    req.Category,
+			Transitions: req.Transitions,
+		}, req.Position)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, status)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete project task status
+// @Description  Delete workflow status which isn't used by tasks. The last status of the project can't be deleted
+// @Tags		 statuses
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        status_id path string true "status id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/statuses/{status_id} [delete]
+func (h statusesHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "statusesHandlers.Delete")
+		defer span.End()
+
+		if err := h.statusesUC.Delete(ctx, c.GetInt64("project_id"), c.GetInt64("status_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
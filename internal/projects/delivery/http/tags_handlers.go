@@ -0,0 +1,151 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tagsHandlers struct {
+	tagsUC projects.TagsUseCase
+	log    logger.Logger
+	tracer trace.Tracer
+}
+
+func NewTagsHandlers(tagsUC projects.TagsUseCase, log logger.Logger) projects.TagHandlers {
+	return tagsHandlers{tagsUC: tagsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project tags
+// @Description  Get tags of the project time entries, sorted by name
+// @Tags		 tags
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Tag
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tags [get]
+func (h tagsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tagsHandlers.Get")
+		defer span.End()
+
+		tags, err := h.tagsUC.Get(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, tags)
+	}
+}
+
+// Create godoc
+// @Summary      Create project tag
+// @Description  Create project tag. Tag names are unique within the project
+// @Tags		 tags
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 tagBody body  models.Tag true "tag to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Tag
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tags [post]
+func (h tagsHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tagsHandlers.Create")
+		defer span.End()
+
+		tag := &models.Tag{}
+		if err := utils.ReadRequest(c, tag); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		tag.ProjectID = c.GetInt64("project_id")
+
+		tag, err := h.tagsUC.Create(ctx, tag)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, tag)
+	}
+}
+
+// Update godoc
+// @Summary      Rename project tag
+// @Description  Rename project tag
+// @Tags		 tags
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        tag_id path string true "tag id"
+// @Param		 tagBody body  models.Tag true "updates to the tag"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Tag
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tags/{tag_id} [patch]
+func (h tagsHandlers) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tagsHandlers.Update")
+		defer span.End()
+
+		tag := &models.Tag{}
+		if err := utils.ReadRequest(c, tag); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		tag.ID = c.GetInt64("tag_id")
+		tag.ProjectID = c.GetInt64("project_id")
+
+		tag, err := h.tagsUC.Update(ctx, tag)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, tag)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete project tag
+// @Description  Delete project tag, it is removed from all time entries
+// @Tags		 tags
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        tag_id path string true "tag id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tags/{tag_id} [delete]
+func (h tagsHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tagsHandlers.Delete")
+		defer span.End()
+
+		if err := h.tagsUC.Delete(ctx, c.GetInt64("project_id"), c.GetInt64("tag_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
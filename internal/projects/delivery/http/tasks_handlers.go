@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
 )
 
 type tasksHandlers struct {
@@ -20,12 +21,26 @@ type tasksHandlers struct {
 
 // Get godoc
 // @Summary      Get project tasks
-// @Description  Get project tasks
+// @Description  Get page of project tasks matching filters. Next page is requested with next_cursor of the previous one and the same filters and sort
 // @Tags		 tasks
 // @Produce      json
 // @Param        project_id path string true "project id"
+// @Param		 status_id query []integer false "tasks in these statuses only" collectionFormat(multi)
+// @Param		 finished query boolean false "tasks in done or not done statuses only"
+// @Param		 assignee_id query integer false "tasks of this member only"
+// @Param		 search query string false "part of task name"
+// @Param		 created_from query string false "tasks created at or after this time (RFC3339)"
+// @Param		 created_to query string false "tasks created before this time (RFC3339)"
+// @Param		 updated_from query string false "tasks updated at or after this time (RFC3339)"
+// @Param		 updated_to query string false "tasks updated before this time (RFC3339)"
+// @Param		 parent_id query integer false "subtasks of this task only, 0 for top-level tasks"
+// @Param		 label_id query []int false "tasks with the labels" collectionFormat(multi)
+// @Param		 label_match query string false "any or all of the labels, any by default"
+// @Param		 sort query string false "board, or id, name, created_at, updated_at or priority prefixed with - for descending order. Board order by status position and rank within the status by default"
+// @Param		 limit query integer false "page size, 50 by default, 500 at most"
+// @Param		 cursor query string false "next_cursor of the previous page"
 // @Param        X-Access-Token header string true "Token that you get after authorization/registration"
-// @Success      200  {object}  []models.Task
+// @Success      200  {object}  utils.TasksQueryResponse
 // @Failure      400  {object}  httpErrors.RestError
 // @Failure      500  {object}  httpErrors.RestError
 // @Router       /projects/{project_id}/tasks [get]
@@ -36,7 +51,14 @@ func (h tasksHandlers) Get() gin.HandlerFunc {
 
 		projectID := c.GetInt64("project_id")
 
-		results, err := h.tasksUC.Get(ctx, projectID)
+		query := &utils.TasksQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		results, err := h.tasksUC.Get(ctx, projectID, query)
 		if err != nil {
 			utils.LogResponseError(c, h.log, err)
 			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
@@ -47,9 +69,38 @@ func (h tasksHandlers) Get() gin.HandlerFunc {
 	}
 }
 
+// GetByID godoc
+// @Summary      Get project task
+// @Description  Get project task with its members, subtasks, tracked time in total and per member and running entries. Time of subtasks rolls up to the task, own_seconds is tracked in the task itself. Tracked time is cached for a minute
+// @Tags		 tasks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TaskDetail
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id} [get]
+func (h tasksHandlers) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.GetByID")
+		defer span.End()
+
+		task, err := h.tasksUC.GetByID(ctx, c.GetInt64("project_id"), c.GetInt64("task_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, task)
+	}
+}
+
 // Create godoc
 // @Summary      Create project task
-// @Description  Create project task
+// @Description  Create project task. Estimated duration of the task in seconds is optional, status is the first todo status of the project by default. Parent makes it a subtask of another task of the project. Priority is medium by default, the task is placed at the end of its status column
 // @Tags		 tasks
 // @Produce      json
 // @Param        project_id path string true "project id"
@@ -85,7 +136,7 @@ func (h tasksHandlers) Create() gin.HandlerFunc {
 
 // Update godoc
 // @Summary      Update project task
-// @Description  Update project task
+// @Description  Update project task. Zero estimate removes estimated duration of the task, zero parent makes it top-level task. Status can be changed according to the project workflow, moving to done status stops running entries of the task and task moved to another status is placed at the end of its column
 // @Tags		 tasks
 // @Produce      json
 // @Param        project_id path string true "project id"
@@ -94,6 +145,8 @@ func (h tasksHandlers) Create() gin.HandlerFunc {
 // @Param        X-Access-Token header string true "Token that you get after authorization/registration"
 // @Success      200  {object}  models.Task
 // @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
 // @Failure      500  {object}  httpErrors.RestError
 // @Router       /projects/{project_id}/tasks/{task_id} [patch]
 func (h tasksHandlers) Update() gin.HandlerFunc {
@@ -119,6 +172,88 @@ func (h tasksHandlers) Update() gin.HandlerFunc {
 	}
 }
 
+// Bulk godoc
+// @Summary      Bulk change project tasks
+// @Description  Create, update, delete tasks and manage their members in one transaction. Every item is validated like in the single task endpoints, nothing is applied if any item fails. Members are managed by project owner and admins only
+// @Tags		 tasks
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 bulkBody body  utils.BulkTasksRequest true "batch of changes, up to 100 items of each kind"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.BulkTasksResponse
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      422  {object}  utils.BulkTasksResponse
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/bulk [post]
+func (h tasksHandlers) Bulk() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.Bulk")
+		defer span.End()
+
+		batch := &utils.BulkTasksRequest{}
+		if err := utils.ReadRequest(c, batch); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		response, err := h.tasksUC.Bulk(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"), batch)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		if !response.Ok {
+			c.JSON(http.StatusUnprocessableEntity, response)
+			return
+		}
+		c.JSON(200, response)
+	}
+}
+
+// Move godoc
+// @Summary      Move project task on the board
+// @Description  Place the task right before or after another task of the project. Task moved next to the task of another status is moved to that status according to the project workflow
+// @Tags		 tasks
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 moveBody body  http.MoveTaskRequest true "sibling task to place the task next to"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Task
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/move [post]
+func (h tasksHandlers) Move() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.Move")
+		defer span.End()
+
+		req := &MoveTaskRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		siblingID, after := req.BeforeID, false
+		if req.AfterID != 0 {
+			siblingID, after = req.AfterID, true
+		}
+
+		task, err := h.tasksUC.Move(ctx, c.GetInt64("project_id"), c.GetInt64("task_id"), siblingID, after)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, task)
+	}
+}
+
 // Delete godoc
 // @Summary      Delete project task
 // @Description  Delete project task
@@ -148,16 +283,104 @@ func (h tasksHandlers) Delete() gin.HandlerFunc {
 	}
 }
 
+// GetEstimates godoc
+// @Summary      Get project estimates
+// @Description  Get estimated, actual and remaining time and variance per task and for the whole project. Positive variance means the task is over its estimate
+// @Tags		 tasks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.ProjectEstimate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/estimates [get]
+func (h tasksHandlers) GetEstimates() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.GetEstimates")
+		defer span.End()
+
+		estimates, err := h.tasksUC.GetEstimates(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, estimates)
+	}
+}
+
+// GetOverEstimate godoc
+// @Summary      Get project tasks over estimate
+// @Description  Get project tasks with more tracked time than estimated, the most exceeded first
+// @Tags		 tasks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.TaskEstimate
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/estimates/over [get]
+func (h tasksHandlers) GetOverEstimate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.GetOverEstimate")
+		defer span.End()
+
+		estimates, err := h.tasksUC.GetOverEstimate(ctx, c.GetInt64("project_id"))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, estimates)
+	}
+}
+
+// GetDue godoc
+// @Summary      Get due tasks of the user
+// @Description  Get unfinished tasks of the current user across projects, which are overdue or due within the duration
+// @Tags		 tasks
+// @Produce      json
+// @Param        within query string false "duration like 72h, the server default if empty"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.DueTasks
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/tasks/due [get]
+func (h tasksHandlers) GetDue() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.GetDue")
+		defer span.End()
+
+		query := &utils.DueTasksQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		due, err := h.tasksUC.GetDue(ctx, c.MustGet("user").(*models.User).ID, query.Within)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, due)
+	}
+}
+
 // Start godoc
 // @Summary      Start doing project task
-// @Description  Start doing project task
+// @Description  Start doing project task. Description and tags of the time entry are optional. Task with unfinished blockers is refused, or started with blocked_by warning if the project allows
 // @Tags		 tasks
+// @Accept       json
 // @Produce      json
 // @Param        project_id path string true "project id"
 // @Param        task_id path string true "task id"
+// @Param		 startBody body  http.StartTaskRequest false "description and tags of the time entry"
 // @Param        X-Access-Token header string true "Token that you get after authorization/registration"
-// @Success      200  {object}  utils.Response
+// @Success      200  {object}  http.StartTaskResponse
 // @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
 // @Failure      500  {object}  httpErrors.RestError
 // @Router       /projects/{project_id}/tasks/{task_id}/start [post]
 func (h tasksHandlers) Start() gin.HandlerFunc {
@@ -165,15 +388,30 @@ func (h tasksHandlers) Start() gin.HandlerFunc {
 		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.Start")
 		defer span.End()
 
-		user := c.MustGet("user").(*models.User)
-		taskID := c.GetInt64("task_id")
+		req := &StartTaskRequest{}
+		if c.Request.ContentLength != 0 {
+			if err := utils.ReadRequest(c, req); err != nil {
+				utils.LogResponseError(c, h.log, err)
+				c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+				return
+			}
+		}
 
-		if err := h.tasksUC.Start(ctx, taskID, user.ID); err != nil {
+		user := c.MustGet("user").(*models.User)
+		entry := &models.TimeEntry{
+			TaskID:      c.GetInt64("task_id"),
+			UserID:      user.ID,
+			Description: req.Description,
+			TagIDs:      req.TagIDs,
+			Billable:    req.Billable,
+		}
+		blockers, err := h.tasksUC.Start(ctx, entry)
+		if err != nil {
 			utils.LogResponseError(c, h.log, err)
 			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
 			return
 		}
-		c.JSON(200, utils.Response{Ok: true})
+		c.JSON(200, StartTaskResponse{Ok: true, BlockedBy: blockers})
 	}
 }
 
@@ -206,6 +444,70 @@ func (h tasksHandlers) Stop() gin.HandlerFunc {
 	}
 }
 
+// Pause godoc
+// @Summary      Pause doing project task
+// @Description  Pause running time entry of the task. Paused time isn't counted in the entry duration
+// @Tags		 tasks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      423  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/pause [post]
+func (h tasksHandlers) Pause() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.Pause")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+		taskID := c.GetInt64("task_id")
+
+		if err := h.tasksUC.Pause(ctx, taskID, user.ID); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// Resume godoc
+// @Summary      Resume doing project task
+// @Description  Resume paused time entry of the task
+// @Tags		 tasks
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      423  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/resume [post]
+func (h tasksHandlers) Resume() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "tasksHandlers.Resume")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+		taskID := c.GetInt64("task_id")
+
+		if err := h.tasksUC.Resume(ctx, taskID, user.ID); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
 // GetMembers godoc
 // @Summary      Get task executors
 // @Description  Get task executors
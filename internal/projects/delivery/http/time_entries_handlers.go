@@ -0,0 +1,389 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/export"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type timeEntriesHandlers struct {
+	entriesUC projects.TimeEntriesUseCase
+	log       logger.Logger
+	tracer    trace.Tracer
+}
+
+func NewTimeEntriesHandlers(entriesUC projects.TimeEntriesUseCase, log logger.Logger) projects.TimeEntryHandlers {
+	return timeEntriesHandlers{entriesUC: entriesUC, tracer: otel.GetTracerProvider().Tracer("api"),
+		log: log}
+}
+
+// Get godoc
+// @Summary      Get task time entries
+// @Description  Get task time entries, newest first
+// @Tags		 entries
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 user_id query integer false "show entries of this user only"
+// @Param		 from query string false "entries started at or after this time (RFC3339)"
+// @Param		 to query string false "entries started before this time (RFC3339)"
+// @Param		 tag_id query integer false "entries with this tag only"
+// @Param		 format query string false "json, csv or xlsx, selected by Accept header if omitted"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.TimeEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/entries [get]
+func (h timeEntriesHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.Get")
+		defer span.End()
+
+		projectID := c.GetInt64("project_id")
+		taskID := c.GetInt64("task_id")
+
+		query := &utils.TimeEntriesQuery{}
+		if err := c.BindQuery(query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		format, err := exportFormat(c)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		if format != formatJSON {
+			writeExport(c, h.log, format, fmt.Sprintf("task-%d-entries", taskID), timeEntryColumns,
+				func(w export.Writer) error {
+					return h.entriesUC.Export(ctx, projectID, taskID, query, func(entry *models.TimeEntry) error {
+						return w.Write(timeEntryRecord(entry))
+					})
+				})
+			return
+		}
+
+		entries, err := h.entriesUC.Get(ctx, projectID, taskID, query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, entries)
+	}
+}
+
+// GetByID godoc
+// @Summary      Get time entry
+// @Description  Get time entry
+// @Tags		 entries
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        entry_id path string true "time entry id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TimeEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/entries/{entry_id} [get]
+func (h timeEntriesHandlers) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.GetByID")
+		defer span.End()
+
+		projectID := c.GetInt64("project_id")
+		taskID := c.GetInt64("task_id")
+		entryID := c.GetInt64("entry_id")
+
+		entry, err := h.entriesUC.GetByID(ctx, projectID, taskID, entryID)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, entry)
+	}
+}
+
+// Create godoc
+// @Summary      Create time entry manually
+// @Description  Create finished time entry of the current user
+// @Tags		 entries
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param		 entryBody body  http.CreateTimeEntryRequest true "time entry to be created"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TimeEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/entries [post]
+func (h timeEntriesHandlers) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.Create")
+		defer span.End()
+
+		req := &CreateTimeEntryRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		entry, err := h.entriesUC.Create(ctx, c.GetInt64("project_id"), &models.TimeEntry{
+			TaskID:      c.GetInt64("task_id"),
+			UserID:      c.MustGet("user").(*models.User).ID,
+			StartedAt:   req.StartedAt,
+			EndedAt:     &req.EndedAt,
+			Description: req.Description,
+			TagIDs:      req.TagIDs,
+			Billable:    req.Billable,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, entry)
+	}
+}
+
+// Update godoc
+// @Summary      Update time entry
+// @Description  Correct start/end, description, tags and billable flag of the time entry or move it to another task of the project, which its author is a member of. Only author, project owner and admins can do it
+// @Tags		 entries
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        entry_id path string true "time entry id"
+// @Param		 entryUpdates body  http.UpdateTimeEntryRequest true "updates to the time entry"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TimeEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/entries/{entry_id} [patch]
+func (h timeEntriesHandlers) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.Update")
+		defer span.End()
+
+		req := &UpdateTimeEntryRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		updates := &models.TimeEntry{
+			ID:          c.GetInt64("entry_id"),
+			TaskID:      req.TaskID,
+			EndedAt:     req.EndedAt,
+			Description: req.Description,
+			Billable:    req.Billable,
+		}
+		if req.StartedAt != nil {
+			updates.StartedAt = *req.StartedAt
+		}
+		if req.TagIDs != nil {
+			updates.TagIDs = append(pq.Int64Array{}, *req.TagIDs...)
+		}
+
+		user := c.MustGet("user").(*models.User)
+		entry, err := h.entriesUC.Update(ctx, user, c.GetInt64("project_id"), c.GetInt64("task_id"), updates)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, entry)
+	}
+}
+
+// Delete godoc
+// @Summary      Delete time entry
+// @Description  Delete time entry. Only author, project owner and admins can do it
+// @Tags		 entries
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        task_id path string true "task id"
+// @Param        entry_id path string true "time entry id"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  utils.Response
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/tasks/{task_id}/entries/{entry_id} [delete]
+func (h timeEntriesHandlers) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.Delete")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+		if err := h.entriesUC.Delete(ctx, user, c.GetInt64("project_id"), c.GetInt64("task_id"),
+			c.GetInt64("entry_id")); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, utils.Response{Ok: true})
+	}
+}
+
+// GetActive godoc
+// @Summary      Get current timer
+// @Description  Get running time entries of the current user with their tasks and projects
+// @Tags		 entries
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.ActiveTimeEntry
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/timer [get]
+func (h timeEntriesHandlers) GetActive() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.GetActive")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+
+		entries, err := h.entriesUC.GetActive(ctx, user.ID)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, entries)
+	}
+}
+
+// Switch godoc
+// @Summary      Switch timer to another task
+// @Description  Stop all running time entries of the current user in any project and start the task in one transaction
+// @Tags		 entries
+// @Accept       json
+// @Produce      json
+// @Param		 switchTimerBody body  http.SwitchTimerRequest true "task to be started"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  http.SwitchTimerResponse
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      403  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      423  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /timer/switch [post]
+func (h timeEntriesHandlers) Switch() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.Switch")
+		defer span.End()
+
+		req := &SwitchTimerRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		user := c.MustGet("user").(*models.User)
+		entry, blockers, err := h.entriesUC.Switch(ctx, user, req.TaskID)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, SwitchTimerResponse{TimeEntry: entry, BlockedBy: blockers})
+	}
+}
+
+// GetSettings godoc
+// @Summary      Get timer settings
+// @Description  Get settings used to stop forgotten timers of the current user
+// @Tags		 entries
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TimerSettings
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/timer/settings [get]
+func (h timeEntriesHandlers) GetSettings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.GetSettings")
+		defer span.End()
+
+		user := c.MustGet("user").(*models.User)
+
+		settings, err := h.entriesUC.GetSettings(ctx, user.ID)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, settings)
+	}
+}
+
+// UpdateSettings godoc
+// @Summary      Update timer settings
+// @Description  Set end of the workday (HH:MM) and timezone of the current user. Timers running past the end of the workday are stopped automatically
+// @Tags		 entries
+// @Accept       json
+// @Produce      json
+// @Param		 settingsBody body  models.TimerSettings true "timer settings"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.TimerSettings
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /users/me/timer/settings [put]
+func (h timeEntriesHandlers) UpdateSettings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timeEntriesHandlers.UpdateSettings")
+		defer span.End()
+
+		settings := &models.TimerSettings{}
+		if err := utils.ReadRequest(c, settings); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		settings.UserID = c.MustGet("user").(*models.User).ID
+
+		settings, err := h.entriesUC.UpdateSettings(ctx, settings)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		c.JSON(200, settings)
+	}
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+type timesheetsHandlers struct {
+	timesheetsUC projects.TimesheetsUseCase
+	log          logger.Logger
+	tracer       trace.Tracer
+}
+
+func NewTimesheetsHandlers(timesheetsUC projects.TimesheetsUseCase, log logger.Logger) projects.TimesheetHandlers {
+	return timesheetsHandlers{timesheetsUC: timesheetsUC, tracer: otel.GetTracerProvider().Tracer("api"), log: log}
+}
+
+// Get godoc
+// @Summary      Get project timesheets
+// @Description  Get weekly timesheets of the project members with worked seconds, newest weeks first
+// @Tags		 timesheets
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 user_id query integer false "show timesheets of this user only"
+// @Param		 status query string false "submitted, approved or rejected"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Timesheet
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/timesheets [get]
+func (h timesheetsHandlers) Get() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timesheetsHandlers.Get")
+		defer span.End()
+
+		query := &utils.TimesheetsQuery{}
+		if err := utils.ReadRequest(c, query); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		timesheets, err := h.timesheetsUC.Get(ctx, c.GetInt64("project_id"), query)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, timesheets)
+	}
+}
+
+// GetPending godoc
+// @Summary      Get pending timesheets
+// @Description  Get submitted timesheets waiting for approval of the current user. Admins see timesheets of all projects, owners of their projects
+// @Tags		 timesheets
+// @Produce      json
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  []models.Timesheet
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /timesheets/pending [get]
+func (h timesheetsHandlers) GetPending() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timesheetsHandlers.GetPending")
+		defer span.End()
+
+		timesheets, err := h.timesheetsUC.GetPending(ctx, c.MustGet("user").(*models.User))
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, timesheets)
+	}
+}
+
+// Submit godoc
+// @Summary      Submit timesheet
+// @Description  Submit the week of the current user in the project for approval. Rejected week can be submitted again, week with a running timer can't be submitted
+// @Tags		 timesheets
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param		 timesheetBody body  http.SubmitTimesheetRequest true "monday of the week"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Timesheet
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/timesheets [post]
+func (h timesheetsHandlers) Submit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timesheetsHandlers.Submit")
+		defer span.End()
+
+		req := &SubmitTimesheetRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		// Date is validated by ReadRequest
+		weekStart, _ := time.Parse(time.DateOnly, req.WeekStart)
+
+		timesheet, err := h.timesheetsUC.Submit(ctx, &models.Timesheet{
+			ProjectID: c.GetInt64("project_id"),
+			UserID:    c.MustGet("user").(*models.User).ID,
+			WeekStart: weekStart,
+		})
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, timesheet)
+	}
+}
+
+// Approve godoc
+// @Summary      Approve timesheet
+// @Description  Approve submitted timesheet. Entries of approved week can't be stopped, changed or deleted, so week with a running timer can't be approved
+// @Tags		 timesheets
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        timesheet_id path string true "timesheet id"
+// @Param		 timesheetBody body  http.ApproveTimesheetRequest false "optional comment"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Timesheet
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/timesheets/{timesheet_id}/approve [put]
+func (h timesheetsHandlers) Approve() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timesheetsHandlers.Approve")
+		defer span.End()
+
+		req := &ApproveTimesheetRequest{}
+		if c.Request.ContentLength != 0 {
+			if err := utils.ReadRequest(c, req); err != nil {
+				utils.LogResponseError(c, h.log, err)
+				c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+				return
+			}
+		}
+
+		timesheet, err := h.timesheetsUC.Approve(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"),
+			c.GetInt64("timesheet_id"), req.Comment)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, timesheet)
+	}
+}
+
+// Reject godoc
+// @Summary      Reject timesheet
+// @Description  Reject submitted timesheet with comment, the member can submit it again
+// @Tags		 timesheets
+// @Accept       json
+// @Produce      json
+// @Param        project_id path string true "project id"
+// @Param        timesheet_id path string true "timesheet id"
+// @Param		 timesheetBody body  http.RejectTimesheetRequest true "reason of rejection"
+// @Param        X-Access-Token header string true "Token that you get after authorization/registration"
+// @Success      200  {object}  models.Timesheet
+// @Failure      400  {object}  httpErrors.RestError
+// @Failure      404  {object}  httpErrors.RestError
+// @Failure      409  {object}  httpErrors.RestError
+// @Failure      500  {object}  httpErrors.RestError
+// @Router       /projects/{project_id}/timesheets/{timesheet_id}/reject [put]
+func (h timesheetsHandlers) Reject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := h.tracer.Start(c.MustGet(utils.UserCtxKey).(context.Context), "timesheetsHandlers.Reject")
+		defer span.End()
+
+		req := &RejectTimesheetRequest{}
+		if err := utils.ReadRequest(c, req); err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+
+		timesheet, err := h.timesheetsUC.Reject(ctx, c.MustGet("user").(*models.User), c.GetInt64("project_id"),
+			c.GetInt64("timesheet_id"), req.Comment)
+		if err != nil {
+			utils.LogResponseError(c, h.log, err)
+			c.AbortWithStatusJSON(httpErrors.ErrorResponse(err))
+			return
+		}
+		c.JSON(200, timesheet)
+	}
+}
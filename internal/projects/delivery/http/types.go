@@ -1,5 +1,139 @@
 package http
 
+import (
+	"github.com/armanokka/time_tracker/internal/models"
+	"time"
+)
+
 type AddTaskMemberRequest struct {
 	UserID int64 `json:"user_id"`
 }
+
+// MoveTaskRequest places the task right before or after the sibling, exactly one of them is set
+type MoveTaskRequest struct {
+	BeforeID int64 `json:"before_id" validate:"required_without=AfterID,excluded_with=AfterID"`
+	AfterID  int64 `json:"after_id" validate:"required_without=BeforeID,excluded_with=BeforeID"`
+}
+
+type AttachTaskLabelRequest struct {
+	LabelID int64 `json:"label_id" validate:"required"`
+}
+
+type AddTaskBlockerRequest struct {
+	BlockerID int64 `json:"blocker_id" validate:"required"`
+}
+
+// StartTaskResponse lists unfinished blockers of the task started in the project which only warns about them
+type StartTaskResponse struct {
+	Ok        bool                     `json:"ok"`
+	BlockedBy []*models.DependencyTask `json:"blocked_by,omitempty"`
+}
+
+type StartTaskRequest struct {
+	Description *string `json:"description" validate:"omitempty,lte=1024"`
+	TagIDs      []int64 `json:"tag_ids" validate:"omitempty"`
+	// Billable is taken from the task or the project if omitted
+	Billable *bool `json:"billable" validate:"omitempty"`
+}
+
+type CreateTimeEntryRequest struct {
+	StartedAt   time.Time `json:"started_at" validate:"required"`
+	EndedAt     time.Time `json:"ended_at" validate:"required"`
+	Description *string   `json:"description" validate:"omitempty,lte=1024"`
+	TagIDs      []int64   `json:"tag_ids" validate:"omitempty"`
+	Billable    *bool     `json:"billable" validate:"omitempty"`
+}
+
+type SwitchTimerRequest struct {
+	TaskID int64 `json:"task_id" validate:"required"`
+}
+
+// SwitchTimerResponse is the started entry with unfinished blockers of the task in the project which only warns about them
+type SwitchTimerResponse struct {
+	*models.TimeEntry
+	BlockedBy []*models.DependencyTask `json:"blocked_by,omitempty"`
+}
+
+type UpdateTimeEntryRequest struct {
+	TaskID    int64      `json:"task_id" validate:"omitempty"`
+	StartedAt *time.Time `json:"started_at" validate:"omitempty"`
+	EndedAt   *time.Time `json:"ended_at" validate:"omitempty"`
+	// Description and TagIDs are kept if omitted, empty values clear them
+	Description *string  `json:"description" validate:"omitempty,lte=1024"`
+	TagIDs      *[]int64 `json:"tag_ids" validate:"omitempty"`
+	Billable    *bool    `json:"billable" validate:"omitempty"`
+}
+
+type CreateUserRateRequest struct {
+	Rate          float64 `json:"rate" validate:"gte=0"`
+	EffectiveFrom string  `json:"effective_from" validate:"required,datetime=2006-01-02"`
+}
+
+type CreateProjectRateRequest struct {
+	// UserID is set for the rate of the project member, otherwise the rate applies to all members
+	UserID        *int64  `json:"user_id" validate:"omitempty"`
+	Rate          float64 `json:"rate" validate:"gte=0"`
+	EffectiveFrom string  `json:"effective_from" validate:"required,datetime=2006-01-02"`
+}
+
+type CreateInvoiceRequest struct {
+	From    string  `json:"from" validate:"required,datetime=2006-01-02"`
+	To      string  `json:"to" validate:"required,datetime=2006-01-02"`
+	GroupBy string  `json:"group_by" validate:"omitempty,oneof=task member"`
+	TaxRate float64 `json:"tax_rate" validate:"gte=0,lte=100"`
+}
+
+type UpdateInvoiceStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=sent paid"`
+}
+
+type DownloadInvoiceQuery struct {
+	Format string `form:"format" validate:"omitempty,oneof=pdf json"`
+}
+
+type SubmitTimesheetRequest struct {
+	// WeekStart is monday of the submitted week
+	WeekStart string `json:"week_start" validate:"required,datetime=2006-01-02"`
+}
+
+type ApproveTimesheetRequest struct {
+	Comment *string `json:"comment" validate:"omitempty,lte=1024"`
+}
+
+type RejectTimesheetRequest struct {
+	Comment string `json:"comment" validate:"required,lte=1024"`
+}
+
+type SetLockRequest struct {
+	// LockedUntil is the last locked date, entries started on it or earlier can't be changed
+	LockedUntil string `json:"locked_until" validate:"required,datetime=2006-01-02"`
+}
+
+type SetBudgetRequest struct {
+	// Kind is hours or money, money budget is consumed by billable amount of the entries
+	Kind   string  `json:"kind" validate:"required,oneof=hours money"`
+	Amount float64 `json:"amount" validate:"required,gt=0"`
+	Period string  `json:"period" validate:"required,oneof=total monthly"`
+	// Thresholds in percents notified to the project owner, 50, 80 and 100 by default
+	Thresholds []int64 `json:"thresholds" validate:"omitempty,max=10,unique,dive,gte=1,lte=1000"`
+	// BlockStart refuses starting timers for non-owners when the budget is exceeded
+	BlockStart bool `json:"block_start"`
+}
+
+type CreateTaskStatusRequest struct {
+	Name string `json:"name" validate:"required,lte=64"`
+	// Category is todo, in_progress or done. Moving task to done status stops its running entries
+	Category string `json:"category" validate:"required,oneof=todo in_progress done"`
+	// Position in the workflow, the end of the workflow by default
+	Position *int `json:"position" validate:"omitempty,gte=0"`
+	// Transitions are statuses the task can be moved to, any status is allowed if empty
+	Transitions []int64 `json:"transitions" validate:"omitempty,dive,gt=0"`
+}
+
+type UpdateTaskStatusRequest struct {
+	Name     string `json:"name" validate:"omitempty,lte=64"`
+	Category string `json:"category" validate:"omitempty,oneof=todo in_progress done"`
+	Position *int   `json:"position" validate:"omitempty,gte=0"`
+	// Transitions replace allowed transitions of the status unless null, empty list allows any status
+	Transitions []int64 `json:"transitions" validate:"omitempty,dive,gt=0"`
+}
@@ -3,6 +3,8 @@ package projects
 import (
 	"context"
 	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"time"
 )
 
 type Repository interface {
@@ -17,20 +19,166 @@ type Repository interface {
 	GetMembers(ctx context.Context, projectID int64) ([]*models.User, error)
 	AddMember(ctx context.Context, projectID, userID int64) error
 	RemoveMember(ctx context.Context, projectID, userID int64) error
-	GetMemberProductivity(ctx context.Context, projectID, userID int64) ([]models.UserProductivity, error)
+	GetMemberProductivity(ctx context.Context, projectID, userID int64, query *utils.ProductivityQuery) ([]models.UserProductivity, error)
 }
 
 type TasksRepository interface {
-	Get(ctx context.Context, projectID int64) ([]*models.Task, error)
+	Get(ctx context.Context, projectID int64, query *utils.TasksQuery) (utils.TasksQueryResponse, error)
+	GetByID(ctx context.Context, taskID int64) (*models.Task, error)
 	Create(ctx context.Context, task *models.Task) (*models.Task, error)
 	Update(ctx context.Context, task *models.Task) (*models.Task, error)
+	SetStatus(ctx context.Context, task *models.Task, stopEntries bool) (*models.Task, error)
+	Move(ctx context.Context, taskID, siblingID int64, after bool) (*models.Task, error)
+	Bulk(ctx context.Context, batch *utils.BulkTasksRequest, maxDepth int) error
 	Delete(ctx context.Context, taskID int64) error
 
-	Start(ctx context.Context, taskID, userID int64) error
+	GetEstimates(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error)
+	GetOverEstimate(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error)
+
+	Start(ctx context.Context, entry *models.TimeEntry, policy string) error
 	Stop(ctx context.Context, taskID, userID int64) error
+	Pause(ctx context.Context, taskID, userID int64) error
+	Resume(ctx context.Context, taskID, userID int64) error
 
 	GetMembers(ctx context.Context, taskID int64) ([]*models.User, error)
+	GetSubtasks(ctx context.Context, taskID int64) ([]*models.Task, error)
+	GetLabels(ctx context.Context, taskID int64) ([]*models.Label, error)
+	GetDepth(ctx context.Context, taskID int64) (int, error)
+	GetHeight(ctx context.Context, taskID int64) (int, error)
+	IsSubtask(ctx context.Context, taskID, subtaskID int64) (bool, error)
+	GetAncestorIDs(ctx context.Context, taskID int64) ([]int64, error)
+	GetMembersTime(ctx context.Context, taskID int64) ([]*models.TaskMemberTime, error)
+	GetRunningEntries(ctx context.Context, taskID int64) ([]*models.ActiveTimeEntry, error)
+	GetMemberIDs(ctx context.Context, taskID int64) ([]int64, error)
+	GetDue(ctx context.Context, userID int64, within time.Duration) ([]*models.Task, error)
+	ClaimDueReminders(ctx context.Context, before time.Duration,
+		fn func(task *models.Task) (*models.Notification, error)) (int64, error)
 	AddMember(ctx context.Context, taskID, userID int64) error
 	DeleteMember(ctx context.Context, taskID, userID int64) error
 	IsMember(ctx context.Context, taskID, userID int64) error
 }
+
+type TimeEntriesRepository interface {
+	Get(ctx context.Context, taskID int64, query *utils.TimeEntriesQuery) ([]*models.TimeEntry, error)
+	Stream(ctx context.Context, taskID int64, query *utils.TimeEntriesQuery, fn func(entry *models.TimeEntry) error) error
+	GetByID(ctx context.Context, taskID, entryID int64) (*models.TimeEntry, error)
+	Create(ctx context.Context, entry *models.TimeEntry) (*models.TimeEntry, error)
+	Update(ctx context.Context, entry *models.TimeEntry) (*models.TimeEntry, error)
+	Delete(ctx context.Context, taskID, entryID int64) error
+
+	IsProjectTask(ctx context.Context, projectID, taskID int64) error
+	CountOverlapping(ctx context.Context, entry *models.TimeEntry) (int, error)
+	IsApproved(ctx context.Context, taskID, userID int64, startedAt time.Time) (bool, error)
+	IsLocked(ctx context.Context, taskID int64, at time.Time) (bool, error)
+
+	GetActive(ctx context.Context, userID int64) ([]*models.ActiveTimeEntry, error)
+	Switch(ctx context.Context, taskID, userID int64) (*models.TimeEntry, error)
+	AutoStop(ctx context.Context, maxDuration time.Duration) (int64, error)
+
+	GetSettings(ctx context.Context, userID int64) (*models.TimerSettings, error)
+	UpdateSettings(ctx context.Context, settings *models.TimerSettings) (*models.TimerSettings, error)
+}
+
+type LabelsRepository interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Label, error)
+	Create(ctx context.Context, label *models.Label) (*models.Label, error)
+	Update(ctx context.Context, label *models.Label) (*models.Label, error)
+	Delete(ctx context.Context, projectID, labelID int64) error
+	Attach(ctx context.Context, taskID, labelID int64) error
+	Detach(ctx context.Context, taskID, labelID int64) error
+}
+
+type TagsRepository interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Tag, error)
+	Create(ctx context.Context, tag *models.Tag) (*models.Tag, error)
+	Update(ctx context.Context, tag *models.Tag) (*models.Tag, error)
+	Delete(ctx context.Context, projectID, tagID int64) error
+}
+
+type InvoicesRepository interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Invoice, error)
+	GetByID(ctx context.Context, projectID, invoiceID int64) (*models.Invoice, error)
+	Create(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error)
+	UpdateStatus(ctx context.Context, invoice *models.Invoice, prevStatus string) (*models.Invoice, error)
+	Delete(ctx context.Context, projectID, invoiceID int64) error
+}
+
+type RatesRepository interface {
+	GetUserRates(ctx context.Context, userID int64) ([]*models.HourlyRate, error)
+	GetProjectRates(ctx context.Context, projectID int64) ([]*models.HourlyRate, error)
+	Create(ctx context.Context, rate *models.HourlyRate) (*models.HourlyRate, error)
+	Delete(ctx context.Context, projectID, rateID int64) error
+}
+
+type TimesheetsRepository interface {
+	Get(ctx context.Context, projectID int64, query *utils.TimesheetsQuery) ([]*models.Timesheet, error)
+	GetPending(ctx context.Context, approverID int64, all bool) ([]*models.Timesheet, error)
+	GetByID(ctx context.Context, projectID, timesheetID int64) (*models.Timesheet, error)
+	Submit(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error)
+	HasRunningEntries(ctx context.Context, projectID, userID int64, weekStart time.Time) (bool, error)
+	Review(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error)
+}
+
+type LocksRepository interface {
+	Get(ctx context.Context) ([]*models.PeriodLock, error)
+	GetByProject(ctx context.Context, projectID int64) (*models.PeriodLock, error)
+	Set(ctx context.Context, lock *models.PeriodLock) (*models.PeriodLock, error)
+	Delete(ctx context.Context, projectID int64) error
+}
+
+type ReportsRepository interface {
+	Get(ctx context.Context, userID int64, admin bool, query *utils.ReportQuery) ([]*models.ReportRow, error)
+	Stream(ctx context.Context, userID int64, admin bool, query *utils.ReportQuery, fn func(row *models.ReportRow) error) error
+	GetEntries(ctx context.Context, userID int64, admin bool, filter *utils.ReportFilter) ([]*models.ReportEntry, error)
+	StreamEntries(ctx context.Context, userID int64, admin bool, filter *utils.ReportFilter, fn func(entry *models.ReportEntry) error) error
+}
+
+type BudgetsRepository interface {
+	GetByProject(ctx context.Context, projectID int64) (*models.ProjectBudget, error)
+	GetByTask(ctx context.Context, taskID int64) (*models.ProjectBudget, error)
+	Set(ctx context.Context, budget *models.ProjectBudget) (*models.ProjectBudget, error)
+	Delete(ctx context.Context, projectID int64) error
+
+	GetConsumption(ctx context.Context, projectID int64, periodStart *time.Time) (*models.BudgetConsumption, error)
+	CreateAlerts(ctx context.Context, projectID int64, periodStart *time.Time, thresholds []int64) ([]int64, error)
+}
+
+type NotificationsRepository interface {
+	Get(ctx context.Context, userID int64, query *utils.NotificationsQuery) ([]*models.Notification, error)
+	Create(ctx context.Context, notification *models.Notification) (*models.Notification, error)
+	Read(ctx context.Context, userID, notificationID int64) (*models.Notification, error)
+	ReadAll(ctx context.Context, userID int64) (int64, error)
+}
+
+type StatusesRepository interface {
+	Get(ctx context.Context, projectID int64) ([]*models.TaskStatus, error)
+	GetByID(ctx context.Context, projectID, statusID int64) (*models.TaskStatus, error)
+	Create(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error)
+	Update(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error)
+	Delete(ctx context.Context, projectID, statusID int64) error
+	IsTransitionAllowed(ctx context.Context, fromStatusID, toStatusID int64) (bool, error)
+}
+
+type DependenciesRepository interface {
+	Get(ctx context.Context, taskID int64) ([]*models.DependencyTask, error)
+	Create(ctx context.Context, projectID int64, dependency *models.TaskDependency) error
+	Delete(ctx context.Context, taskID, blockerID int64) error
+	GetGraph(ctx context.Context, projectID int64) (*models.DependencyGraph, error)
+}
+
+type CommentsRepository interface {
+	Get(ctx context.Context, taskID int64, query *utils.CommentsQuery) ([]*models.TaskComment, error)
+	GetByID(ctx context.Context, taskID, commentID int64) (*models.TaskComment, error)
+	Create(ctx context.Context, comment *models.TaskComment) (*models.TaskComment, error)
+	Update(ctx context.Context, comment *models.TaskComment) (*models.TaskComment, error)
+	Delete(ctx context.Context, taskID, commentID int64) error
+}
+
+type AttachmentsRepository interface {
+	Get(ctx context.Context, taskID int64) ([]*models.TaskAttachment, error)
+	GetByID(ctx context.Context, taskID, attachmentID int64) (*models.TaskAttachment, error)
+	Create(ctx context.Context, attachment *models.TaskAttachment) (*models.TaskAttachment, error)
+	Delete(ctx context.Context, taskID, attachmentID int64) error
+	GetOrphans(ctx context.Context, limit int) ([]string, error)
+	DeleteOrphans(ctx context.Context, keys []string) error
+}
@@ -10,3 +10,9 @@ type RedisRepository interface {
 	SetProject(ctx context.Context, project *models.Project, seconds int64) error
 	DeleteProject(ctx context.Context, projectID int64) error
 }
+
+type TasksRedisRepository interface {
+	GetTask(ctx context.Context, taskID int64) (*models.TaskDetail, error)
+	SetTask(ctx context.Context, task *models.TaskDetail, seconds int64) error
+	DeleteTask(ctx context.Context, taskID int64) error
+}
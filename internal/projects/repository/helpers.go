@@ -3,14 +3,19 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	_ "github.com/jackc/pgx/stdlib" // pgx driver
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"log"
+	"os"
+	"path/filepath"
+	"time"
 )
 
 func getTestProject() *models.Project {
@@ -29,7 +34,143 @@ func getTestTask() *models.Task {
 		Name:        "Lorem",
 		Description: "Ipsum doromet",
 		ProjectID:   4,
-		Finished:    false,
+		StatusID:    2,
+		CreatedAt:   time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC),
+		Priority:    models.TaskPriorityMedium,
+		Rank:        "i",
+	}
+}
+
+func getTestTimeEntry() *models.TimeEntry {
+	startedAt := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(90 * time.Minute)
+	return &models.TimeEntry{
+		ID:        7,
+		TaskID:    1,
+		UserID:    10,
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+	}
+}
+
+func getTestTag() *models.Tag {
+	return &models.Tag{
+		ID:        3,
+		ProjectID: 1,
+		Name:      "meetings",
+	}
+}
+
+func getTestLabel() *models.Label {
+	return &models.Label{
+		ID:        6,
+		ProjectID: 4,
+		Name:      "bug",
+		Color:     "#d32f2f",
+	}
+}
+
+func getTestHourlyRate() *models.HourlyRate {
+	var userID, projectID int64 = 10, 1
+	return &models.HourlyRate{
+		ID:            2,
+		UserID:        &userID,
+		ProjectID:     &projectID,
+		Rate:          40.5,
+		EffectiveFrom: "2024-07-01",
+	}
+}
+
+func getTestInvoice() *models.Invoice {
+	return &models.Invoice{
+		ID:         5,
+		Number:     12,
+		ProjectID:  1,
+		CreatedBy:  10,
+		PeriodFrom: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		PeriodTo:   time.Date(2024, 7, 31, 0, 0, 0, 0, time.UTC),
+		GroupBy:    models.InvoiceGroupByTask,
+		TaxRate:    20,
+		Subtotal:   100,
+		Tax:        20,
+		Total:      120,
+		Status:     models.InvoiceStatusDraft,
+		CreatedAt:  time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func getTestTimesheet() *models.Timesheet {
+	return &models.Timesheet{
+		ID:           6,
+		ProjectID:    1,
+		UserID:       10,
+		WeekStart:    time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		Status:       models.TimesheetStatusSubmitted,
+		SubmittedAt:  time.Date(2024, 7, 8, 9, 0, 0, 0, time.UTC),
+		TotalSeconds: 40 * 60 * 60,
+	}
+}
+
+func getTestPeriodLock() *models.PeriodLock {
+	var projectID, updatedBy int64 = 1, 10
+	return &models.PeriodLock{
+		ID:          2,
+		ProjectID:   &projectID,
+		LockedUntil: "2024-06-30",
+		UpdatedBy:   &updatedBy,
+		UpdatedAt:   time.Date(2024, 7, 5, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func getTestProjectBudget() *models.ProjectBudget {
+	var updatedBy int64 = 10
+	return &models.ProjectBudget{
+		ProjectID:  1,
+		Kind:       models.BudgetKindHours,
+		Amount:     120,
+		Period:     models.BudgetPeriodMonthly,
+		Thresholds: []int64{50, 80, 100},
+		BlockStart: true,
+		UpdatedBy:  &updatedBy,
+		UpdatedAt:  time.Date(2024, 7, 5, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func getTestNotification() *models.Notification {
+	var projectID, taskID int64 = 1, 4
+	return &models.Notification{
+		ID:        6,
+		UserID:    10,
+		Type:      models.NotificationBudgetThreshold,
+		ProjectID: &projectID,
+		TaskID:    &taskID,
+		Message:   `Project "Some project" has consumed 80.50% of its monthly budget`,
+		Payload:   []byte(`{"threshold":80}`),
+		CreatedAt: time.Date(2024, 7, 5, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func getTestTaskStatus() *models.TaskStatus {
+	return &models.TaskStatus{
+		ID:          2,
+		ProjectID:   4,
+		Name:        "in progress",
+		Category:    models.TaskStatusInProgress,
+		Position:    1,
+		Transitions: []int64{3, 4},
+	}
+}
+
+func getTestInvoiceLine() *models.InvoiceLine {
+	var taskID int64 = 1
+	return &models.InvoiceLine{
+		ID:          8,
+		InvoiceID:   5,
+		TaskID:      &taskID,
+		Description: "Lorem",
+		Seconds:     2 * 3600,
+		Amount:      100,
 	}
 }
 
@@ -57,6 +198,52 @@ func SetupRedis(ctx context.Context) (testcontainers.Container, *redis.Client) {
 	})
 }
 
+// SetupPostgres launches local Postgres instance via testcontainers and applies up migrations.
+// Returned testcontainers.Container MUST be terminated
+func SetupPostgres(ctx context.Context) (testcontainers.Container, *sqlx.DB) {
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:14.6",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_USER":     "user",
+			"POSTGRES_PASSWORD": "password",
+			"POSTGRES_DB":       "database",
+		},
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2),
+	}
+	postgresC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Could not start postgres: %s", err)
+	}
+	endpoint, err := postgresC.PortEndpoint(ctx, "5432/tcp", "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := sqlx.ConnectContext(ctx, "pgx", fmt.Sprintf("postgres://user:password@%s/database?sslmode=disable", endpoint))
+	if err != nil {
+		log.Fatalf("Could not connect to postgres: %s", err)
+	}
+
+	migrations, err := filepath.Glob("../../../migrations/*.up.sql")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, migration := range migrations {
+		query, err := os.ReadFile(migration)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err = db.ExecContext(ctx, string(query)); err != nil {
+			log.Fatalf("Could not apply migration %s: %s", migration, err)
+		}
+	}
+	return postgresC, db
+}
+
 func newMockProjectsRepo() (projects.Repository, *sql.DB, sqlmock.Sqlmock, error) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -74,3 +261,153 @@ func newMockTasksRepo() (projects.TasksRepository, *sql.DB, sqlmock.Sqlmock, err
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	return NewTasksRepository(sqlxDB), db, mock, nil
 }
+
+func newMockTimeEntriesRepo() (projects.TimeEntriesRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewTimeEntriesRepository(sqlxDB), db, mock, nil
+}
+
+func newMockTagsRepo() (projects.TagsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewTagsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockLabelsRepo() (projects.LabelsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewLabelsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockRatesRepo() (projects.RatesRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewRatesRepository(sqlxDB), db, mock, nil
+}
+
+func newMockInvoicesRepo() (projects.InvoicesRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewInvoicesRepository(sqlxDB), db, mock, nil
+}
+
+func newMockTimesheetsRepo() (projects.TimesheetsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewTimesheetsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockLocksRepo() (projects.LocksRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewLocksRepository(sqlxDB), db, mock, nil
+}
+
+func newMockReportsRepo() (projects.ReportsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewReportsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockBudgetsRepo() (projects.BudgetsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewBudgetsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockNotificationsRepo() (projects.NotificationsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewNotificationsRepository(sqlxDB), db, mock, nil
+}
+
+func newMockStatusesRepo() (projects.StatusesRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewStatusesRepository(sqlxDB), db, mock, nil
+}
+
+func newMockDependenciesRepo() (projects.DependenciesRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewDependenciesRepository(sqlxDB), db, mock, nil
+}
+
+func newMockCommentsRepo() (projects.CommentsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewCommentsRepository(sqlxDB), db, mock, nil
+}
+
+func getTestTaskComment() *models.TaskComment {
+	return &models.TaskComment{
+		ID:        3,
+		TaskID:    1,
+		AuthorID:  10,
+		Body:      "Blocked by the **review**, @11 could you take a look?",
+		Mentions:  []int64{11},
+		CreatedAt: time.Date(2024, 7, 5, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func newMockAttachmentsRepo() (projects.AttachmentsRepository, *sql.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	return NewAttachmentsRepository(sqlxDB), db, mock, nil
+}
+
+func getTestTaskAttachment() *models.TaskAttachment {
+	return &models.TaskAttachment{
+		ID:          5,
+		TaskID:      1,
+		UploaderID:  10,
+		Name:        "screenshot.png",
+		ContentType: "image/png",
+		Size:        2048,
+		StorageKey:  "tasks/1/9f86d081884c7d659a2feaa0c55ad015",
+		CreatedAt:   time.Date(2024, 7, 5, 10, 0, 0, 0, time.UTC),
+	}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type attachmentsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewAttachmentsRepository(db *sqlx.DB) projects.AttachmentsRepository {
+	return attachmentsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (a attachmentsRepository) Get(ctx context.Context, taskID int64) ([]*models.TaskAttachment, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.Get")
+	defer span.End()
+
+	attachments := make([]*models.TaskAttachment, 0, 10)
+	if err := a.db.SelectContext(ctx, &attachments, selectTaskAttachmentsQuery, taskID); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (a attachmentsRepository) GetByID(ctx context.Context, taskID, attachmentID int64) (*models.TaskAttachment, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.GetByID")
+	defer span.End()
+
+	var attachment models.TaskAttachment
+	if err := a.db.QueryRowxContext(ctx, getTaskAttachmentQuery, attachmentID, taskID).StructScan(&attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (a attachmentsRepository) Create(ctx context.Context, attachment *models.TaskAttachment) (*models.TaskAttachment, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.Create")
+	defer span.End()
+
+	return attachment, a.db.QueryRowxContext(ctx, createTaskAttachmentQuery, attachment.TaskID, attachment.UploaderID,
+		attachment.Name, attachment.ContentType, attachment.Size, attachment.StorageKey).StructScan(attachment)
+}
+
+func (a attachmentsRepository) Delete(ctx context.Context, taskID, attachmentID int64) error {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.Delete")
+	defer span.End()
+
+	result, err := a.db.ExecContext(ctx, deleteTaskAttachmentQuery, attachmentID, taskID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetOrphans returns storage keys of deleted attachments, the oldest first
+func (a attachmentsRepository) GetOrphans(ctx context.Context, limit int) ([]string, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.GetOrphans")
+	defer span.End()
+
+	keys := make([]string, 0, limit)
+	if err := a.db.SelectContext(ctx, &keys, selectStorageOrphansQuery, limit); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// DeleteOrphans forgets storage keys removed from the storage
+func (a attachmentsRepository) DeleteOrphans(ctx context.Context, keys []string) error {
+	ctx, span := a.tracer.Start(ctx, "attachmentsRepository.DeleteOrphans")
+	defer span.End()
+
+	_, err := a.db.ExecContext(ctx, deleteStorageOrphansQuery, pq.Array(keys))
+	return err
+}
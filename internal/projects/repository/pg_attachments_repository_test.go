@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAttachmentsRepository_Get(t *testing.T) {
+	attachmentsRepo, db, mock, err := newMockAttachmentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	attachment := getTestTaskAttachment()
+
+	mock.ExpectQuery(selectTaskAttachmentsQuery).WithArgs(attachment.TaskID).
+		WillReturnRows(sqlmock.NewRows(attachment.Columns()).AddRow(attachment.Fields()...))
+
+	attachments, err := attachmentsRepo.Get(context.Background(), attachment.TaskID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskAttachment{attachment}, attachments)
+}
+
+func TestAttachmentsRepository_GetByID(t *testing.T) {
+	attachmentsRepo, db, mock, err := newMockAttachmentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	attachment := getTestTaskAttachment()
+
+	mock.ExpectQuery(getTaskAttachmentQuery).WithArgs(attachment.ID, attachment.TaskID).
+		WillReturnRows(sqlmock.NewRows(attachment.Columns()).AddRow(attachment.Fields()...))
+
+	gotAttachment, err := attachmentsRepo.GetByID(context.Background(), attachment.TaskID, attachment.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, attachment, gotAttachment)
+}
+
+func TestAttachmentsRepository_Create(t *testing.T) {
+	attachmentsRepo, db, mock, err := newMockAttachmentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	attachment := getTestTaskAttachment()
+	request := &models.TaskAttachment{TaskID: attachment.TaskID, UploaderID: attachment.UploaderID,
+		Name: attachment.Name, ContentType: attachment.ContentType, Size: attachment.Size,
+		StorageKey: attachment.StorageKey}
+
+	mock.ExpectQuery(createTaskAttachmentQuery).WithArgs(request.TaskID, request.UploaderID, request.Name,
+		request.ContentType, request.Size, request.StorageKey).
+		WillReturnRows(sqlmock.NewRows(attachment.Columns()).AddRow(attachment.Fields()...))
+
+	gotAttachment, err := attachmentsRepo.Create(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, attachment, gotAttachment)
+}
+
+func TestAttachmentsRepository_Delete(t *testing.T) {
+	attachmentsRepo, db, mock, err := newMockAttachmentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	attachment := getTestTaskAttachment()
+
+	mock.ExpectExec(deleteTaskAttachmentQuery).WithArgs(attachment.ID, attachment.TaskID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, attachmentsRepo.Delete(context.Background(), attachment.TaskID, attachment.ID))
+
+	mock.ExpectExec(deleteTaskAttachmentQuery).WithArgs(attachment.ID, attachment.TaskID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = attachmentsRepo.Delete(context.Background(), attachment.TaskID, attachment.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestAttachmentsRepository_Orphans(t *testing.T) {
+	attachmentsRepo, db, mock, err := newMockAttachmentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	attachment := getTestTaskAttachment()
+	keys := []string{attachment.StorageKey}
+
+	mock.ExpectQuery(selectStorageOrphansQuery).WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"storage_key"}).AddRow(attachment.StorageKey))
+	mock.ExpectExec(deleteStorageOrphansQuery).WithArgs(pq.Array(keys)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	gotKeys, err := attachmentsRepo.GetOrphans(context.Background(), 100)
+	assert.Nil(t, err)
+	assert.Equal(t, keys, gotKeys)
+
+	err = attachmentsRepo.DeleteOrphans(context.Background(), gotKeys)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
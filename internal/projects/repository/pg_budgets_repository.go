@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+type budgetsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewBudgetsRepository(db *sqlx.DB) projects.BudgetsRepository {
+	return budgetsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (b budgetsRepository) GetByProject(ctx context.Context, projectID int64) (*models.ProjectBudget, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.GetByProject")
+	defer span.End()
+
+	var budget models.ProjectBudget
+	if err := b.db.GetContext(ctx, &budget, getBudgetQuery, projectID); err != nil {
+		return nil, err
+	}
+	return &budget, nil
+}
+
+func (b budgetsRepository) GetByTask(ctx context.Context, taskID int64) (*models.ProjectBudget, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.GetByTask")
+	defer span.End()
+
+	var budget models.ProjectBudget
+	if err := b.db.GetContext(ctx, &budget, getTaskBudgetQuery, taskID); err != nil {
+		return nil, err
+	}
+	return &budget, nil
+}
+
+// Set creates or replaces the budget of the project and resets crossed thresholds
+func (b budgetsRepository) Set(ctx context.Context, budget *models.ProjectBudget) (*models.ProjectBudget, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.Set")
+	defer span.End()
+
+	tx, err := b.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err = tx.QueryRowxContext(ctx, setBudgetQuery, budget.ProjectID, budget.Kind, budget.Amount, budget.Period,
+		budget.Thresholds, budget.BlockStart, budget.UpdatedBy).StructScan(budget); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, deleteBudgetAlertsQuery, budget.ProjectID); err != nil {
+		return nil, err
+	}
+	return budget, tx.Commit()
+}
+
+func (b budgetsRepository) Delete(ctx context.Context, projectID int64) error {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.Delete")
+	defer span.End()
+
+	result, err := b.db.ExecContext(ctx, deleteBudgetQuery, projectID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetConsumption returns tracked seconds and billable amount of the project entries started since periodStart
+func (b budgetsRepository) GetConsumption(ctx context.Context, projectID int64, periodStart *time.Time) (*models.BudgetConsumption, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.GetConsumption")
+	defer span.End()
+
+	consumption := &models.BudgetConsumption{ProjectID: projectID, PeriodStart: periodStart}
+	if err := b.db.GetContext(ctx, consumption, getBudgetConsumptionQuery, projectID, periodStart); err != nil {
+		return nil, err
+	}
+	return consumption, nil
+}
+
+// CreateAlerts records crossed thresholds of the period and returns the ones which weren't recorded before
+func (b budgetsRepository) CreateAlerts(ctx context.Context, projectID int64, periodStart *time.Time, thresholds []int64) ([]int64, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsRepository.CreateAlerts")
+	defer span.End()
+
+	created := make([]int64, 0, len(thresholds))
+	if err := b.db.SelectContext(ctx, &created, createBudgetAlertsQuery, projectID, periodStart,
+		pq.Array(thresholds)); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestBudgetsRepository_GetByProject(t *testing.T) {
+	budgetsRepo, db, mock, err := newMockBudgetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	budget := getTestProjectBudget()
+
+	mock.ExpectQuery(getBudgetQuery).WithArgs(budget.ProjectID).
+		WillReturnRows(sqlmock.NewRows(budget.Columns()).AddRow(budget.Fields()...))
+
+	gotBudget, err := budgetsRepo.GetByProject(context.Background(), budget.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, budget, gotBudget)
+
+	var taskID int64 = 4
+	mock.ExpectQuery(getTaskBudgetQuery).WithArgs(taskID).WillReturnError(sql.ErrNoRows)
+
+	gotBudget, err = budgetsRepo.GetByTask(context.Background(), taskID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, gotBudget)
+}
+
+func TestBudgetsRepository_Set(t *testing.T) {
+	budgetsRepo, db, mock, err := newMockBudgetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	budget := getTestProjectBudget()
+	request := &models.ProjectBudget{ProjectID: budget.ProjectID, Kind: budget.Kind, Amount: budget.Amount,
+		Period: budget.Period, Thresholds: budget.Thresholds, BlockStart: budget.BlockStart, UpdatedBy: budget.UpdatedBy}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(setBudgetQuery).WithArgs(request.ProjectID, request.Kind, request.Amount, request.Period,
+		request.Thresholds, request.BlockStart, request.UpdatedBy).
+		WillReturnRows(sqlmock.NewRows(budget.Columns()).AddRow(budget.Fields()...))
+	mock.ExpectExec(deleteBudgetAlertsQuery).WithArgs(budget.ProjectID).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	gotBudget, err := budgetsRepo.Set(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, budget, gotBudget)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestBudgetsRepository_Delete(t *testing.T) {
+	budgetsRepo, db, mock, err := newMockBudgetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 1
+
+	mock.ExpectExec(deleteBudgetQuery).WithArgs(projectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, budgetsRepo.Delete(context.Background(), projectID))
+
+	mock.ExpectExec(deleteBudgetQuery).WithArgs(projectID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, budgetsRepo.Delete(context.Background(), projectID), sql.ErrNoRows)
+}
+
+func TestBudgetsRepository_GetConsumption(t *testing.T) {
+	budgetsRepo, db, mock, err := newMockBudgetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 1
+	periodStart := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(getBudgetConsumptionQuery).WithArgs(projectID, &periodStart).
+		WillReturnRows(sqlmock.NewRows([]string{"tracked_seconds", "amount"}).AddRow(5400, 60.75))
+
+	consumption, err := budgetsRepo.GetConsumption(context.Background(), projectID, &periodStart)
+	assert.Nil(t, err)
+	assert.Equal(t, &models.BudgetConsumption{ProjectID: projectID, PeriodStart: &periodStart, TrackedSeconds: 5400,
+		Amount: 60.75}, consumption)
+}
+
+func TestBudgetsRepository_CreateAlerts(t *testing.T) {
+	budgetsRepo, db, mock, err := newMockBudgetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 1
+	thresholds := []int64{50, 80}
+
+	// 50% was notified before
+	mock.ExpectQuery(createBudgetAlertsQuery).WithArgs(projectID, nil, pq.Array(thresholds)).
+		WillReturnRows(sqlmock.NewRows([]string{"threshold"}).AddRow(80))
+
+	created, err := budgetsRepo.CreateAlerts(context.Background(), projectID, nil, thresholds)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{80}, created)
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type commentsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewCommentsRepository(db *sqlx.DB) projects.CommentsRepository {
+	return commentsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (c commentsRepository) Get(ctx context.Context, taskID int64, query *utils.CommentsQuery) ([]*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsRepository.Get")
+	defer span.End()
+
+	rows, err := c.db.QueryxContext(ctx, selectTaskCommentsQuery, taskID, query.After, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*models.TaskComment, 0, query.Limit)
+	for rows.Next() {
+		var comment models.TaskComment
+		if err = rows.StructScan(&comment); err != nil {
+			return nil, err
+		}
+		comments = append(comments, &comment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (c commentsRepository) GetByID(ctx context.Context, taskID, commentID int64) (*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsRepository.GetByID")
+	defer span.End()
+
+	var comment models.TaskComment
+	if err := c.db.QueryRowxContext(ctx, getTaskCommentQuery, commentID, taskID).StructScan(&comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (c commentsRepository) Create(ctx context.Context, comment *models.TaskComment) (*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsRepository.Create")
+	defer span.End()
+
+	return comment, c.db.QueryRowxContext(ctx, createTaskCommentQuery, comment.TaskID, comment.AuthorID, comment.Body,
+		comment.Mentions).StructScan(comment)
+}
+
+func (c commentsRepository) Update(ctx context.Context, comment *models.TaskComment) (*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsRepository.Update")
+	defer span.End()
+
+	return comment, c.db.QueryRowxContext(ctx, updateTaskCommentQuery, comment.Body, comment.Mentions, comment.ID,
+		comment.TaskID).StructScan(comment)
+}
+
+func (c commentsRepository) Delete(ctx context.Context, taskID, commentID int64) error {
+	ctx, span := c.tracer.Start(ctx, "commentsRepository.Delete")
+	defer span.End()
+
+	result, err := c.db.ExecContext(ctx, deleteTaskCommentQuery, commentID, taskID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCommentsRepository_Get(t *testing.T) {
+	commentsRepo, db, mock, err := newMockCommentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	comment := getTestTaskComment()
+	query := &utils.CommentsQuery{After: 2, Limit: 100}
+
+	mock.ExpectQuery(selectTaskCommentsQuery).WithArgs(comment.TaskID, query.After, query.Limit).
+		WillReturnRows(sqlmock.NewRows(comment.Columns()).AddRow(comment.Fields()...))
+
+	comments, err := commentsRepo.Get(context.Background(), comment.TaskID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskComment{comment}, comments)
+}
+
+func TestCommentsRepository_GetByID(t *testing.T) {
+	commentsRepo, db, mock, err := newMockCommentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	comment := getTestTaskComment()
+
+	mock.ExpectQuery(getTaskCommentQuery).WithArgs(comment.ID, comment.TaskID).
+		WillReturnRows(sqlmock.NewRows(comment.Columns()).AddRow(comment.Fields()...))
+
+	gotComment, err := commentsRepo.GetByID(context.Background(), comment.TaskID, comment.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, comment, gotComment)
+}
+
+func TestCommentsRepository_Create(t *testing.T) {
+	commentsRepo, db, mock, err := newMockCommentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	comment := getTestTaskComment()
+	request := &models.TaskComment{TaskID: comment.TaskID, AuthorID: comment.AuthorID, Body: comment.Body,
+		Mentions: comment.Mentions}
+
+	mock.ExpectQuery(createTaskCommentQuery).WithArgs(request.TaskID, request.AuthorID, request.Body, request.Mentions).
+		WillReturnRows(sqlmock.NewRows(comment.Columns()).AddRow(comment.Fields()...))
+
+	gotComment, err := commentsRepo.Create(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, comment, gotComment)
+}
+
+func TestCommentsRepository_Update(t *testing.T) {
+	commentsRepo, db, mock, err := newMockCommentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	comment := getTestTaskComment()
+	editedAt := time.Date(2024, 7, 5, 11, 0, 0, 0, time.UTC)
+	comment.EditedAt = &editedAt
+	request := &models.TaskComment{ID: comment.ID, TaskID: comment.TaskID, Body: comment.Body,
+		Mentions: comment.Mentions}
+
+	mock.ExpectQuery(updateTaskCommentQuery).WithArgs(request.Body, request.Mentions, request.ID, request.TaskID).
+		WillReturnRows(sqlmock.NewRows(comment.Columns()).AddRow(comment.Fields()...))
+
+	gotComment, err := commentsRepo.Update(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, comment, gotComment)
+}
+
+func TestCommentsRepository_Delete(t *testing.T) {
+	commentsRepo, db, mock, err := newMockCommentsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	comment := getTestTaskComment()
+
+	mock.ExpectExec(deleteTaskCommentQuery).WithArgs(comment.ID, comment.TaskID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, commentsRepo.Delete(context.Background(), comment.TaskID, comment.ID))
+
+	mock.ExpectExec(deleteTaskCommentQuery).WithArgs(comment.ID, comment.TaskID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = commentsRepo.Delete(context.Background(), comment.TaskID, comment.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
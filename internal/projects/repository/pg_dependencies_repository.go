@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type dependenciesRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewDependenciesRepository(db *sqlx.DB) projects.DependenciesRepository {
+	return dependenciesRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+// Get returns direct blockers of the task
+func (d dependenciesRepository) Get(ctx context.Context, taskID int64) ([]*models.DependencyTask, error) {
+	ctx, span := d.tracer.Start(ctx, "dependenciesRepository.Get")
+	defer span.End()
+
+	blockers := make([]*models.DependencyTask, 0)
+	if err := d.db.SelectContext(ctx, &blockers, selectTaskBlockersQuery, taskID); err != nil {
+		return nil, err
+	}
+	return blockers, nil
+}
+
+// Create adds the blocker to the task unless the blocker is already blocked by the task
+func (d dependenciesRepository) Create(ctx context.Context, projectID int64, dependency *models.TaskDependency) error {
+	ctx, span := d.tracer.Start(ctx, "dependenciesRepository.Create")
+	defer span.End()
+
+	tx, err := d.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, lockProjectDependenciesQuery, projectID); err != nil {
+		return err
+	}
+	var cycle bool
+	if err = tx.GetContext(ctx, &cycle, isTaskBlockedByQuery, dependency.BlockerID, dependency.TaskID); err != nil {
+		return err
+	}
+	if cycle {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.DependencyCycle.Error(), nil)
+	}
+	if _, err = tx.ExecContext(ctx, createTaskDependencyQuery, dependency.TaskID, dependency.BlockerID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d dependenciesRepository) Delete(ctx context.Context, taskID, blockerID int64) error {
+	ctx, span := d.tracer.Start(ctx, "dependenciesRepository.Delete")
+	defer span.End()
+
+	result, err := d.db.ExecContext(ctx, deleteTaskDependencyQuery, taskID, blockerID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (d dependenciesRepository) GetGraph(ctx context.Context, projectID int64) (*models.DependencyGraph, error) {
+	ctx, span := d.tracer.Start(ctx, "dependenciesRepository.GetGraph")
+	defer span.End()
+
+	graph := &models.DependencyGraph{
+		ProjectID:    projectID,
+		Tasks:        make([]*models.DependencyTask, 0),
+		Dependencies: make([]*models.TaskDependency, 0),
+	}
+	if err := d.db.SelectContext(ctx, &graph.Tasks, selectDependencyTasksQuery, projectID); err != nil {
+		return nil, err
+	}
+	if err := d.db.SelectContext(ctx, &graph.Dependencies, selectProjectDependenciesQuery, projectID); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
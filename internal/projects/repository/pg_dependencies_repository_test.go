@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestDependenciesRepository_Get(t *testing.T) {
+	dependenciesRepo, db, mock, err := newMockDependenciesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var taskID int64 = 1
+	blocker := &models.DependencyTask{ID: 2, Name: "Lorem", StatusID: 4, Done: true}
+
+	mock.ExpectQuery(selectTaskBlockersQuery).WithArgs(taskID).
+		WillReturnRows(sqlmock.NewRows(blocker.Columns()).AddRow(blocker.Fields()...))
+
+	blockers, err := dependenciesRepo.Get(context.Background(), taskID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.DependencyTask{blocker}, blockers)
+}
+
+func TestDependenciesRepository_Create(t *testing.T) {
+	dependenciesRepo, db, mock, err := newMockDependenciesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 4
+	dependency := &models.TaskDependency{TaskID: 1, BlockerID: 2}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockProjectDependenciesQuery).WithArgs(projectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(isTaskBlockedByQuery).WithArgs(dependency.BlockerID, dependency.TaskID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(createTaskDependencyQuery).WithArgs(dependency.TaskID, dependency.BlockerID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.Nil(t, dependenciesRepo.Create(context.Background(), projectID, dependency))
+
+	// Blocker already blocked by the task makes a cycle
+	mock.ExpectBegin()
+	mock.ExpectExec(lockProjectDependenciesQuery).WithArgs(projectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(isTaskBlockedByQuery).WithArgs(dependency.BlockerID, dependency.TaskID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+	err = dependenciesRepo.Create(context.Background(), projectID, dependency)
+	assert.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDependenciesRepository_Delete(t *testing.T) {
+	dependenciesRepo, db, mock, err := newMockDependenciesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	dependency := &models.TaskDependency{TaskID: 1, BlockerID: 2}
+
+	mock.ExpectExec(deleteTaskDependencyQuery).WithArgs(dependency.TaskID, dependency.BlockerID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, dependenciesRepo.Delete(context.Background(), dependency.TaskID, dependency.BlockerID))
+
+	mock.ExpectExec(deleteTaskDependencyQuery).WithArgs(dependency.TaskID, dependency.BlockerID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = dependenciesRepo.Delete(context.Background(), dependency.TaskID, dependency.BlockerID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDependenciesRepository_GetGraph(t *testing.T) {
+	dependenciesRepo, db, mock, err := newMockDependenciesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 4
+	task := &models.DependencyTask{ID: 1, Name: "Lorem", StatusID: 2}
+	blocker := &models.DependencyTask{ID: 2, Name: "Ipsum", StatusID: 4, Done: true}
+	dependency := &models.TaskDependency{TaskID: task.ID, BlockerID: blocker.ID}
+
+	mock.ExpectQuery(selectDependencyTasksQuery).WithArgs(projectID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...).AddRow(blocker.Fields()...))
+	mock.ExpectQuery(selectProjectDependenciesQuery).WithArgs(projectID).
+		WillReturnRows(sqlmock.NewRows(dependency.Columns()).AddRow(dependency.Fields()...))
+
+	graph, err := dependenciesRepo.GetGraph(context.Background(), projectID)
+	assert.Nil(t, err)
+	assert.Equal(t, &models.DependencyGraph{
+		ProjectID:    projectID,
+		Tasks:        []*models.DependencyTask{task, blocker},
+		Dependencies: []*models.TaskDependency{dependency},
+	}, graph)
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type invoicesRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewInvoicesRepository(db *sqlx.DB) projects.InvoicesRepository {
+	return invoicesRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (i invoicesRepository) Get(ctx context.Context, projectID int64) ([]*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesRepository.Get")
+	defer span.End()
+
+	rows, err := i.db.QueryxContext(ctx, selectInvoicesQuery, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invoices := make([]*models.Invoice, 0, 10)
+	for rows.Next() {
+		var invoice models.Invoice
+		if err = rows.StructScan(&invoice); err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, &invoice)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// GetByID returns the invoice with its lines
+func (i invoicesRepository) GetByID(ctx context.Context, projectID, invoiceID int64) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesRepository.GetByID")
+	defer span.End()
+
+	invoice := &models.Invoice{}
+	if err := i.db.QueryRowxContext(ctx, getInvoiceByIDQuery, invoiceID, projectID).StructScan(invoice); err != nil {
+		return nil, err
+	}
+
+	invoice.Lines = make([]*models.InvoiceLine, 0, 10)
+	if err := i.db.SelectContext(ctx, &invoice.Lines, selectInvoiceLinesQuery, invoice.ID); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// Create collects uninvoiced billable entries of the project for the period, locks them by the invoice
+// and counts line items and totals in one transaction
+func (i invoicesRepository) Create(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesRepository.Create")
+	defer span.End()
+
+	tx, err := i.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, lockInvoicesQuery); err != nil {
+		return nil, err
+	}
+	if err = tx.GetContext(ctx, &invoice.ID, createInvoiceQuery, invoice.ProjectID, invoice.CreatedBy,
+		invoice.PeriodFrom, invoice.PeriodTo, invoice.GroupBy, invoice.TaxRate); err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, invoiceTimeEntriesQuery, invoice.ID, invoice.ProjectID, invoice.PeriodFrom,
+		invoice.PeriodTo)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.NoBillableTimeEntries.Error(), nil)
+	}
+
+	linesQuery := createInvoiceLinesByTaskQuery
+	if invoice.GroupBy == models.InvoiceGroupByMember {
+		linesQuery = createInvoiceLinesByMemberQuery
+	}
+	if _, err = tx.ExecContext(ctx, linesQuery, invoice.ID); err != nil {
+		return nil, err
+	}
+	if err = tx.QueryRowxContext(ctx, updateInvoiceTotalsQuery, invoice.ID).StructScan(invoice); err != nil {
+		return nil, err
+	}
+
+	invoice.Lines = make([]*models.InvoiceLine, 0, 10)
+	if err = tx.SelectContext(ctx, &invoice.Lines, selectInvoiceLinesQuery, invoice.ID); err != nil {
+		return nil, err
+	}
+	return invoice, tx.Commit()
+}
+
+// UpdateStatus changes status of the invoice if it wasn't changed concurrently
+func (i invoicesRepository) UpdateStatus(ctx context.Context, invoice *models.Invoice, prevStatus string) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesRepository.UpdateStatus")
+	defer span.End()
+
+	return invoice, i.db.QueryRowxContext(ctx, updateInvoiceStatusQuery, invoice.Status, invoice.ID, invoice.ProjectID,
+		prevStatus).StructScan(invoice)
+}
+
+func (i invoicesRepository) Delete(ctx context.Context, projectID, invoiceID int64) error {
+	ctx, span := i.tracer.Start(ctx, "invoicesRepository.Delete")
+	defer span.End()
+
+	result, err := i.db.ExecContext(ctx, deleteInvoiceQuery, invoiceID, projectID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
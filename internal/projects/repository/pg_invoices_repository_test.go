@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestInvoicesRepository_Get(t *testing.T) {
+	invoicesRepo, db, mock, err := newMockInvoicesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	invoice := getTestInvoice()
+
+	mock.ExpectQuery(selectInvoicesQuery).WithArgs(invoice.ProjectID).
+		WillReturnRows(sqlmock.NewRows(invoice.Columns()).AddRow(invoice.Fields()...))
+
+	gotInvoices, err := invoicesRepo.Get(context.Background(), invoice.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Invoice{invoice}, gotInvoices)
+}
+
+func TestInvoicesRepository_GetByID(t *testing.T) {
+	invoicesRepo, db, mock, err := newMockInvoicesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	invoice := getTestInvoice()
+	line := getTestInvoiceLine()
+
+	mock.ExpectQuery(getInvoiceByIDQuery).WithArgs(invoice.ID, invoice.ProjectID).
+		WillReturnRows(sqlmock.NewRows(invoice.Columns()).AddRow(invoice.Fields()...))
+	mock.ExpectQuery(selectInvoiceLinesQuery).WithArgs(invoice.ID).
+		WillReturnRows(sqlmock.NewRows(line.Columns()).AddRow(line.Fields()...))
+
+	gotInvoice, err := invoicesRepo.GetByID(context.Background(), invoice.ProjectID, invoice.ID)
+	assert.Nil(t, err)
+	invoice.Lines = []*models.InvoiceLine{line}
+	assert.Equal(t, invoice, gotInvoice)
+
+	mock.ExpectQuery(getInvoiceByIDQuery).WithArgs(invoice.ID, invoice.ProjectID).WillReturnError(sql.ErrNoRows)
+	_, err = invoicesRepo.GetByID(context.Background(), invoice.ProjectID, invoice.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestInvoicesRepository_Create(t *testing.T) {
+	invoicesRepo, db, mock, err := newMockInvoicesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	invoice := getTestInvoice()
+	line := getTestInvoiceLine()
+	request := &models.Invoice{
+		ProjectID:  invoice.ProjectID,
+		CreatedBy:  invoice.CreatedBy,
+		PeriodFrom: invoice.PeriodFrom,
+		PeriodTo:   invoice.PeriodTo,
+		GroupBy:    models.InvoiceGroupByTask,
+		TaxRate:    invoice.TaxRate,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockInvoicesQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(createInvoiceQuery).WithArgs(request.ProjectID, request.CreatedBy, request.PeriodFrom,
+		request.PeriodTo, request.GroupBy, request.TaxRate).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(invoice.ID))
+	mock.ExpectExec(invoiceTimeEntriesQuery).WithArgs(invoice.ID, request.ProjectID, request.PeriodFrom,
+		request.PeriodTo).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(createInvoiceLinesByTaskQuery).WithArgs(invoice.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateInvoiceTotalsQuery).WithArgs(invoice.ID).
+		WillReturnRows(sqlmock.NewRows(invoice.Columns()).AddRow(invoice.Fields()...))
+	mock.ExpectQuery(selectInvoiceLinesQuery).WithArgs(invoice.ID).
+		WillReturnRows(sqlmock.NewRows(line.Columns()).AddRow(line.Fields()...))
+	mock.ExpectCommit()
+
+	gotInvoice, err := invoicesRepo.Create(context.Background(), request)
+	assert.Nil(t, err)
+	invoice.Lines = []*models.InvoiceLine{line}
+	assert.Equal(t, invoice, gotInvoice)
+
+	// Nothing to invoice
+	request = &models.Invoice{ProjectID: invoice.ProjectID, CreatedBy: invoice.CreatedBy,
+		PeriodFrom: invoice.PeriodFrom, PeriodTo: invoice.PeriodTo, GroupBy: models.InvoiceGroupByMember}
+	mock.ExpectBegin()
+	mock.ExpectExec(lockInvoicesQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(createInvoiceQuery).WithArgs(request.ProjectID, request.CreatedBy, request.PeriodFrom,
+		request.PeriodTo, request.GroupBy, request.TaxRate).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(invoice.ID + 1))
+	mock.ExpectExec(invoiceTimeEntriesQuery).WithArgs(invoice.ID+1, request.ProjectID, request.PeriodFrom,
+		request.PeriodTo).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = invoicesRepo.Create(context.Background(), request)
+	assert.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoicesRepository_UpdateStatus(t *testing.T) {
+	invoicesRepo, db, mock, err := newMockInvoicesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	invoice := getTestInvoice()
+	invoice.Status = models.InvoiceStatusSent
+
+	mock.ExpectQuery(updateInvoiceStatusQuery).
+		WithArgs(invoice.Status, invoice.ID, invoice.ProjectID, models.InvoiceStatusDraft).
+		WillReturnRows(sqlmock.NewRows(invoice.Columns()).AddRow(invoice.Fields()...))
+
+	gotInvoice, err := invoicesRepo.UpdateStatus(context.Background(), invoice, models.InvoiceStatusDraft)
+	assert.Nil(t, err)
+	assert.Equal(t, invoice, gotInvoice)
+}
+
+func TestInvoicesRepository_Delete(t *testing.T) {
+	invoicesRepo, db, mock, err := newMockInvoicesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	invoice := getTestInvoice()
+
+	mock.ExpectExec(deleteInvoiceQuery).WithArgs(invoice.ID, invoice.ProjectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, invoicesRepo.Delete(context.Background(), invoice.ProjectID, invoice.ID))
+
+	// Sent invoice
+	mock.ExpectExec(deleteInvoiceQuery).WithArgs(invoice.ID, invoice.ProjectID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, invoicesRepo.Delete(context.Background(), invoice.ProjectID, invoice.ID), sql.ErrNoRows)
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type labelsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewLabelsRepository(db *sqlx.DB) projects.LabelsRepository {
+	return labelsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (l labelsRepository) Get(ctx context.Context, projectID int64) ([]*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Get")
+	defer span.End()
+
+	rows, err := l.db.QueryxContext(ctx, selectLabelsQuery, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := make([]*models.Label, 0, 10)
+	for rows.Next() {
+		var label models.Label
+		if err = rows.StructScan(&label); err != nil {
+			return nil, err
+		}
+		labels = append(labels, &label)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+func (l labelsRepository) Create(ctx context.Context, label *models.Label) (*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Create")
+	defer span.End()
+
+	return label, l.db.QueryRowxContext(ctx, createLabelQuery, label.ProjectID, label.Name, label.Color).StructScan(label)
+}
+
+func (l labelsRepository) Update(ctx context.Context, label *models.Label) (*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Update")
+	defer span.End()
+
+	return label, l.db.QueryRowxContext(ctx, updateLabelQuery, label.Name, label.Color, label.ID,
+		label.ProjectID).StructScan(label)
+}
+
+func (l labelsRepository) Delete(ctx context.Context, projectID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Delete")
+	defer span.End()
+
+	return l.exec(ctx, deleteLabelQuery, labelID, projectID)
+}
+
+// Attach labels the task, label of another project isn't found
+func (l labelsRepository) Attach(ctx context.Context, taskID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Attach")
+	defer span.End()
+
+	return l.exec(ctx, attachLabelQuery, taskID, labelID)
+}
+
+func (l labelsRepository) Detach(ctx context.Context, taskID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsRepository.Detach")
+	defer span.End()
+
+	return l.exec(ctx, detachLabelQuery, taskID, labelID)
+}
+
+// exec returns sql.ErrNoRows if the query affects no rows
+func (l labelsRepository) exec(ctx context.Context, query string, args ...interface{}) error {
+	result, err := l.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLabelsRepository_Get(t *testing.T) {
+	labelsRepo, db, mock, err := newMockLabelsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	label := getTestLabel()
+
+	mock.ExpectQuery(selectLabelsQuery).WithArgs(label.ProjectID).
+		WillReturnRows(sqlmock.NewRows(label.Columns()).AddRow(label.Fields()...))
+
+	gotLabels, err := labelsRepo.Get(context.Background(), label.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Label{label}, gotLabels)
+}
+
+func TestLabelsRepository_Create(t *testing.T) {
+	labelsRepo, db, mock, err := newMockLabelsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	label := getTestLabel()
+
+	mock.ExpectQuery(createLabelQuery).WithArgs(label.ProjectID, label.Name, label.Color).
+		WillReturnRows(sqlmock.NewRows(label.Columns()).AddRow(label.Fields()...))
+
+	gotLabel, err := labelsRepo.Create(context.Background(), label)
+	assert.Nil(t, err)
+	assert.Equal(t, label, gotLabel)
+}
+
+func TestLabelsRepository_Update(t *testing.T) {
+	labelsRepo, db, mock, err := newMockLabelsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	label := getTestLabel()
+
+	mock.ExpectQuery(updateLabelQuery).WithArgs(label.Name, label.Color, label.ID, label.ProjectID).
+		WillReturnRows(sqlmock.NewRows(label.Columns()).AddRow(label.Fields()...))
+	gotLabel, err := labelsRepo.Update(context.Background(), label)
+	assert.Nil(t, err)
+	assert.Equal(t, label, gotLabel)
+
+	// Label of another project
+	mock.ExpectQuery(updateLabelQuery).WithArgs(label.Name, label.Color, label.ID, label.ProjectID).
+		WillReturnError(sql.ErrNoRows)
+	_, err = labelsRepo.Update(context.Background(), label)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestLabelsRepository_Delete(t *testing.T) {
+	labelsRepo, db, mock, err := newMockLabelsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	label := getTestLabel()
+
+	mock.ExpectExec(deleteLabelQuery).WithArgs(label.ID, label.ProjectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, labelsRepo.Delete(context.Background(), label.ProjectID, label.ID))
+
+	mock.ExpectExec(deleteLabelQuery).WithArgs(label.ID, label.ProjectID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, labelsRepo.Delete(context.Background(), label.ProjectID, label.ID), sql.ErrNoRows)
+}
+
+func TestLabelsRepository_Attach(t *testing.T) {
+	labelsRepo, db, mock, err := newMockLabelsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	label := getTestLabel()
+	var taskID int64 = 1
+
+	mock.ExpectExec(attachLabelQuery).WithArgs(taskID, label.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, labelsRepo.Attach(context.Background(), taskID, label.ID))
+
+	// Label of another project isn't attached
+	mock.ExpectExec(attachLabelQuery).WithArgs(taskID, label.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, labelsRepo.Attach(context.Background(), taskID, label.ID), sql.ErrNoRows)
+
+	mock.ExpectExec(detachLabelQuery).WithArgs(taskID, label.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, labelsRepo.Detach(context.Background(), taskID, label.ID))
+
+	mock.ExpectExec(detachLabelQuery).WithArgs(taskID, label.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, labelsRepo.Detach(context.Background(), taskID, label.ID), sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type locksRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewLocksRepository(db *sqlx.DB) projects.LocksRepository {
+	return locksRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (l locksRepository) Get(ctx context.Context) ([]*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksRepository.Get")
+	defer span.End()
+
+	rows, err := l.db.QueryxContext(ctx, selectLocksQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locks := make([]*models.PeriodLock, 0, 10)
+	for rows.Next() {
+		var lock models.PeriodLock
+		if err = rows.StructScan(&lock); err != nil {
+			return nil, err
+		}
+		locks = append(locks, &lock)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return locks, nil
+}
+
+func (l locksRepository) GetByProject(ctx context.Context, projectID int64) (*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksRepository.GetByProject")
+	defer span.End()
+
+	var lock models.PeriodLock
+	if err := l.db.GetContext(ctx, &lock, getLockQuery, projectID); err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+// Set creates the lock or moves the lock date of the same scope
+func (l locksRepository) Set(ctx context.Context, lock *models.PeriodLock) (*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksRepository.Set")
+	defer span.End()
+
+	return lock, l.db.QueryRowxContext(ctx, setLockQuery, lock.ProjectID, lock.LockedUntil, lock.UpdatedBy).
+		StructScan(lock)
+}
+
+func (l locksRepository) Delete(ctx context.Context, projectID int64) error {
+	ctx, span := l.tracer.Start(ctx, "locksRepository.Delete")
+	defer span.End()
+
+	result, err := l.db.ExecContext(ctx, deleteLockQuery, projectID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLocksRepository_Get(t *testing.T) {
+	locksRepo, db, mock, err := newMockLocksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	lock := getTestPeriodLock()
+	global := getTestPeriodLock()
+	global.ID, global.ProjectID = 1, nil
+
+	mock.ExpectQuery(selectLocksQuery).WillReturnRows(sqlmock.NewRows(lock.Columns()).
+		AddRow(global.Fields()...).AddRow(lock.Fields()...))
+
+	gotLocks, err := locksRepo.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.PeriodLock{global, lock}, gotLocks)
+}
+
+func TestLocksRepository_GetByProject(t *testing.T) {
+	locksRepo, db, mock, err := newMockLocksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	lock := getTestPeriodLock()
+
+	mock.ExpectQuery(getLockQuery).WithArgs(*lock.ProjectID).
+		WillReturnRows(sqlmock.NewRows(lock.Columns()).AddRow(lock.Fields()...))
+
+	gotLock, err := locksRepo.GetByProject(context.Background(), *lock.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, lock, gotLock)
+
+	mock.ExpectQuery(getLockQuery).WithArgs(int64(0)).WillReturnError(sql.ErrNoRows)
+
+	gotLock, err = locksRepo.GetByProject(context.Background(), 0)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, gotLock)
+}
+
+func TestLocksRepository_Set(t *testing.T) {
+	locksRepo, db, mock, err := newMockLocksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	lock := getTestPeriodLock()
+	request := &models.PeriodLock{ProjectID: lock.ProjectID, LockedUntil: lock.LockedUntil, UpdatedBy: lock.UpdatedBy}
+
+	mock.ExpectQuery(setLockQuery).WithArgs(request.ProjectID, request.LockedUntil, request.UpdatedBy).
+		WillReturnRows(sqlmock.NewRows(lock.Columns()).AddRow(lock.Fields()...))
+
+	gotLock, err := locksRepo.Set(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, lock, gotLock)
+}
+
+func TestLocksRepository_Delete(t *testing.T) {
+	locksRepo, db, mock, err := newMockLocksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(deleteLockQuery).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, locksRepo.Delete(context.Background(), 1))
+
+	mock.ExpectExec(deleteLockQuery).WithArgs(int64(0)).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, locksRepo.Delete(context.Background(), 0), sql.ErrNoRows)
+}
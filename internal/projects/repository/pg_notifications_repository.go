@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type notificationsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewNotificationsRepository(db *sqlx.DB) projects.NotificationsRepository {
+	return notificationsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (n notificationsRepository) Get(ctx context.Context, userID int64, query *utils.NotificationsQuery) ([]*models.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsRepository.Get")
+	defer span.End()
+
+	rows, err := n.db.QueryxContext(ctx, selectNotificationsQuery, userID, query.Unread, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]*models.Notification, 0, query.Limit)
+	for rows.Next() {
+		var notification models.Notification
+		if err = rows.StructScan(&notification); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &notification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (n notificationsRepository) Create(ctx context.Context, notification *models.Notification) (*models.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsRepository.Create")
+	defer span.End()
+
+	return notification, n.db.QueryRowxContext(ctx, createNotificationQuery, notification.UserID, notification.Type,
+		notification.ProjectID, notification.TaskID, notification.Message, notification.Payload).StructScan(notification)
+}
+
+func (n notificationsRepository) Read(ctx context.Context, userID, notificationID int64) (*models.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsRepository.Read")
+	defer span.End()
+
+	var notification models.Notification
+	if err := n.db.QueryRowxContext(ctx, readNotificationQuery, notificationID, userID).StructScan(&notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (n notificationsRepository) ReadAll(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsRepository.ReadAll")
+	defer span.End()
+
+	result, err := n.db.ExecContext(ctx, readAllNotificationsQuery, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNotificationsRepository_Get(t *testing.T) {
+	notificationsRepo, db, mock, err := newMockNotificationsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	notification := getTestNotification()
+	query := &utils.NotificationsQuery{Unread: true, Limit: 50}
+
+	mock.ExpectQuery(selectNotificationsQuery).WithArgs(notification.UserID, query.Unread, query.Limit).
+		WillReturnRows(sqlmock.NewRows(notification.Columns()).AddRow(notification.Fields()...))
+
+	notifications, err := notificationsRepo.Get(context.Background(), notification.UserID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Notification{notification}, notifications)
+}
+
+func TestNotificationsRepository_Create(t *testing.T) {
+	notificationsRepo, db, mock, err := newMockNotificationsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	notification := getTestNotification()
+	request := &models.Notification{UserID: notification.UserID, Type: notification.Type,
+		ProjectID: notification.ProjectID, TaskID: notification.TaskID, Message: notification.Message,
+		Payload: notification.Payload}
+
+	mock.ExpectQuery(createNotificationQuery).WithArgs(request.UserID, request.Type, request.ProjectID, request.TaskID,
+		request.Message, request.Payload).
+		WillReturnRows(sqlmock.NewRows(notification.Columns()).AddRow(notification.Fields()...))
+
+	gotNotification, err := notificationsRepo.Create(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, notification, gotNotification)
+}
+
+func TestNotificationsRepository_Read(t *testing.T) {
+	notificationsRepo, db, mock, err := newMockNotificationsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	notification := getTestNotification()
+	readAt := time.Date(2024, 7, 5, 11, 0, 0, 0, time.UTC)
+	notification.ReadAt = &readAt
+
+	mock.ExpectQuery(readNotificationQuery).WithArgs(notification.ID, notification.UserID).
+		WillReturnRows(sqlmock.NewRows(notification.Columns()).AddRow(notification.Fields()...))
+
+	gotNotification, err := notificationsRepo.Read(context.Background(), notification.UserID, notification.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, notification, gotNotification)
+
+	// Notification of another user isn't found
+	mock.ExpectQuery(readNotificationQuery).WithArgs(notification.ID, int64(11)).WillReturnError(sql.ErrNoRows)
+
+	_, err = notificationsRepo.Read(context.Background(), 11, notification.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	mock.ExpectExec(readAllNotificationsQuery).WithArgs(notification.UserID).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	count, err := notificationsRepo.ReadAll(context.Background(), notification.UserID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+}
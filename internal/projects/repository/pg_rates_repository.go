@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ratesRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewRatesRepository(db *sqlx.DB) projects.RatesRepository {
+	return ratesRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (r ratesRepository) GetUserRates(ctx context.Context, userID int64) ([]*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesRepository.GetUserRates")
+	defer span.End()
+
+	return r.selectRates(ctx, selectUserRatesQuery, userID)
+}
+
+func (r ratesRepository) GetProjectRates(ctx context.Context, projectID int64) ([]*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesRepository.GetProjectRates")
+	defer span.End()
+
+	return r.selectRates(ctx, selectProjectRatesQuery, projectID)
+}
+
+func (r ratesRepository) Create(ctx context.Context, rate *models.HourlyRate) (*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesRepository.Create")
+	defer span.End()
+
+	return rate, r.db.QueryRowxContext(ctx, createRateQuery, rate.UserID, rate.ProjectID, rate.Rate,
+		rate.EffectiveFrom).StructScan(rate)
+}
+
+func (r ratesRepository) Delete(ctx context.Context, projectID, rateID int64) error {
+	ctx, span := r.tracer.Start(ctx, "ratesRepository.Delete")
+	defer span.End()
+
+	result, err := r.db.ExecContext(ctx, deleteRateQuery, rateID, projectID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r ratesRepository) selectRates(ctx context.Context, query string, args ...interface{}) ([]*models.HourlyRate, error) {
+	rows, err := r.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := make([]*models.HourlyRate, 0, 5)
+	for rows.Next() {
+		var rate models.HourlyRate
+		if err = rows.StructScan(&rate); err != nil {
+			return nil, err
+		}
+		rates = append(rates, &rate)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
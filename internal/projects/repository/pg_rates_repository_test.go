@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRatesRepository_GetUserRates(t *testing.T) {
+	ratesRepo, db, mock, err := newMockRatesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rate := getTestHourlyRate()
+	rate.ProjectID = nil
+
+	mock.ExpectQuery(selectUserRatesQuery).WithArgs(*rate.UserID).
+		WillReturnRows(sqlmock.NewRows(rate.Columns()).AddRow(rate.Fields()...))
+
+	gotRates, err := ratesRepo.GetUserRates(context.Background(), *rate.UserID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.HourlyRate{rate}, gotRates)
+}
+
+func TestRatesRepository_GetProjectRates(t *testing.T) {
+	ratesRepo, db, mock, err := newMockRatesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	memberRate := getTestHourlyRate()
+	projectRate := getTestHourlyRate()
+	projectRate.ID = 3
+	projectRate.UserID = nil
+
+	mock.ExpectQuery(selectProjectRatesQuery).WithArgs(*projectRate.ProjectID).
+		WillReturnRows(sqlmock.NewRows(projectRate.Columns()).
+			AddRow(projectRate.Fields()...).
+			AddRow(memberRate.Fields()...))
+
+	gotRates, err := ratesRepo.GetProjectRates(context.Background(), *projectRate.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.HourlyRate{projectRate, memberRate}, gotRates)
+}
+
+func TestRatesRepository_Create(t *testing.T) {
+	ratesRepo, db, mock, err := newMockRatesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rate := getTestHourlyRate()
+
+	mock.ExpectQuery(createRateQuery).WithArgs(rate.UserID, rate.ProjectID, rate.Rate, rate.EffectiveFrom).
+		WillReturnRows(sqlmock.NewRows(rate.Columns()).AddRow(rate.Fields()...))
+
+	gotRate, err := ratesRepo.Create(context.Background(), rate)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, gotRate)
+}
+
+func TestRatesRepository_Delete(t *testing.T) {
+	ratesRepo, db, mock, err := newMockRatesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rate := getTestHourlyRate()
+
+	mock.ExpectExec(deleteRateQuery).WithArgs(rate.ID, *rate.ProjectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, ratesRepo.Delete(context.Background(), *rate.ProjectID, rate.ID))
+
+	// Rate of another project
+	mock.ExpectExec(deleteRateQuery).WithArgs(rate.ID, 0).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, ratesRepo.Delete(context.Background(), 0, rate.ID), sql.ErrNoRows)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type reportsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewReportsRepository(db *sqlx.DB) projects.ReportsRepository {
+	return reportsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+// Get returns tracked time visible to the user grouped by one or two dimensions of the query
+func (r reportsRepository) Get(ctx context.Context, userID int64, admin bool, query *utils.ReportQuery) ([]*models.ReportRow, error) {
+	ctx, span := r.tracer.Start(ctx, "reportsRepository.Get")
+	defer span.End()
+
+	results := make([]*models.ReportRow, 0, 10)
+	return results, r.stream(ctx, userID, admin, query, func(row *models.ReportRow) error {
+		results = append(results, row)
+		return nil
+	})
+}
+
+// Stream passes rows of Get to fn one by one as they are read from the database
+func (r reportsRepository) Stream(ctx context.Context, userID int64, admin bool, query *utils.ReportQuery,
+	fn func(row *models.ReportRow) error) error {
+	ctx, span := r.tracer.Start(ctx, "reportsRepository.Stream")
+	defer span.End()
+
+	return r.stream(ctx, userID, admin, query, fn)
+}
+
+// GetEntries returns entries visible to the user, oldest first
+func (r reportsRepository) GetEntries(ctx context.Context, userID int64, admin bool, filter *utils.ReportFilter) ([]*models.ReportEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "reportsRepository.GetEntries")
+	defer span.End()
+
+	entries := make([]*models.ReportEntry, 0, 10)
+	return entries, r.streamEntries(ctx, userID, admin, filter, func(entry *models.ReportEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+}
+
+// StreamEntries passes entries of GetEntries to fn one by one as they are read from the database
+func (r reportsRepository) StreamEntries(ctx context.Context, userID int64, admin bool, filter *utils.ReportFilter,
+	fn func(entry *models.ReportEntry) error) error {
+	ctx, span := r.tracer.Start(ctx, "reportsRepository.StreamEntries")
+	defer span.End()
+
+	return r.streamEntries(ctx, userID, admin, filter, fn)
+}
+
+func (r reportsRepository) stream(ctx context.Context, userID int64, admin bool, query *utils.ReportQuery,
+	fn func(row *models.ReportRow) error) error {
+	var secondGroupBy string
+	if len(query.GroupBy) > 1 {
+		secondGroupBy = query.GroupBy[1]
+	}
+	rows, err := r.db.QueryxContext(ctx, getReportQuery, append(reportFilterArgs(userID, admin, &query.ReportFilter),
+		query.GroupBy[0], secondGroupBy)...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row models.ReportRow
+		if err = rows.StructScan(&row); err != nil {
+			return err
+		}
+		if err = fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r reportsRepository) streamEntries(ctx context.Context, userID int64, admin bool, filter *utils.ReportFilter,
+	fn func(entry *models.ReportEntry) error) error {
+	rows, err := r.db.QueryxContext(ctx, selectReportEntriesQuery, reportFilterArgs(userID, admin, filter)...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry models.ReportEntry
+		if err = rows.StructScan(&entry); err != nil {
+			return err
+		}
+		if err = fn(&entry); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// reportFilterArgs returns arguments of reportEntriesQuery
+func reportFilterArgs(userID int64, admin bool, filter *utils.ReportFilter) []interface{} {
+	return []interface{}{filter.Timezone, filter.From, filter.To, pq.Array(filter.ProjectIDs), pq.Array(filter.TaskIDs),
+		pq.Array(filter.UserIDs), pq.Array(filter.TagIDs), filter.Billable, admin, userID, filter.Rollup, filter.Approved}
+}
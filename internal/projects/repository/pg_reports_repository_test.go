@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestReportsRepository_Get(t *testing.T) {
+	reportsRepo, db, mock, err := newMockReportsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var userID int64 = 10
+	billable := true
+	query := &utils.ReportQuery{
+		ReportFilter: utils.ReportFilter{
+			From:       "2024-07-01",
+			To:         "2024-07-31",
+			ProjectIDs: []int64{1, 2},
+			Billable:   &billable,
+			Timezone:   "UTC",
+		},
+		GroupBy: []string{utils.ReportByProject, utils.ReportByDay},
+	}
+	day := "2024-07-01"
+	row := &models.ReportRow{Key1: "1", Name1: "Some project", Key2: &day, Name2: &day, TotalSeconds: 3600,
+		BillableSeconds: 3600}
+
+	mock.ExpectQuery(getReportQuery).WithArgs(query.Timezone, query.From, query.To, pq.Array(query.ProjectIDs),
+		pq.Array(query.TaskIDs), pq.Array(query.UserIDs), pq.Array(query.TagIDs), query.Billable, false, userID,
+		false, false, utils.ReportByProject, utils.ReportByDay).
+		WillReturnRows(sqlmock.NewRows(row.Columns()).AddRow(row.Fields()...))
+
+	gotRows, err := reportsRepo.Get(context.Background(), userID, false, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.ReportRow{row}, gotRows)
+
+	// Second dimension is empty for single grouping
+	query.GroupBy = []string{utils.ReportByUser}
+	query.Rollup = true
+	row = &models.ReportRow{Key1: "10", Name1: "John Doe", TotalSeconds: 60}
+
+	mock.ExpectQuery(getReportQuery).WithArgs(query.Timezone, query.From, query.To, pq.Array(query.ProjectIDs),
+		pq.Array(query.TaskIDs), pq.Array(query.UserIDs), pq.Array(query.TagIDs), query.Billable, true, userID,
+		true, false, utils.ReportByUser, "").
+		WillReturnRows(sqlmock.NewRows(row.Columns()).AddRow(row.Fields()...))
+
+	gotRows, err = reportsRepo.Get(context.Background(), userID, true, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.ReportRow{row}, gotRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestReportsRepository_StreamEntries(t *testing.T) {
+	reportsRepo, db, mock, err := newMockReportsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var userID int64 = 10
+	filter := &utils.ReportFilter{From: "2024-07-01", To: "2024-07-31", Timezone: "UTC"}
+	startedAt := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(time.Hour)
+	entry := &models.ReportEntry{
+		ID:             7,
+		ProjectID:      1,
+		ProjectName:    "Some project",
+		TaskID:         2,
+		TaskName:       "Lorem",
+		UserID:         userID,
+		UserName:       "John Doe",
+		StartedAt:      startedAt,
+		EndedAt:        &endedAt,
+		LocalStartedAt: startedAt,
+		Seconds:        3600,
+		Billable:       true,
+		Tags:           pq.StringArray{"backend"},
+	}
+	second := *entry
+	second.ID = 8
+
+	mock.ExpectQuery(selectReportEntriesQuery).WithArgs(filter.Timezone, filter.From, filter.To,
+		pq.Array(filter.ProjectIDs), pq.Array(filter.TaskIDs), pq.Array(filter.UserIDs), pq.Array(filter.TagIDs),
+		filter.Billable, false, userID, filter.Rollup, filter.Approved).
+		WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...).AddRow(second.Fields()...))
+
+	// Streaming stops at the first error of the callback
+	var gotEntries []*models.ReportEntry
+	err = reportsRepo.StreamEntries(context.Background(), userID, false, filter, func(entry *models.ReportEntry) error {
+		gotEntries = append(gotEntries, entry)
+		return sql.ErrConnDone
+	})
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.Equal(t, []*models.ReportEntry{entry}, gotEntries)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestReportTotals_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID, parentID, childID, entryID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	require.NoError(t, db.GetContext(ctx, &parentID, `INSERT INTO task (name, project_id)
+VALUES ('Parent', $1) RETURNING id`, projectID))
+	require.NoError(t, db.GetContext(ctx, &childID, `INSERT INTO task (name, project_id, parent_id)
+VALUES ('Child', $1, $2) RETURNING id`, projectID, parentID))
+	_, err := db.ExecContext(ctx, `WITH label AS (INSERT INTO label (project_id, name)
+VALUES ($1, 'Backend'), ($1, 'Frontend') RETURNING id)
+INSERT INTO task_label (task_id, label_id) SELECT $2, id FROM label`, projectID, parentID)
+	require.NoError(t, err)
+
+	// Billable hour of the parent with 10 paused minutes in the approved week
+	require.NoError(t, db.GetContext(ctx, &entryID, `INSERT INTO time_entry (task_id, user_id, started_at, ended_at, billable)
+VALUES ($1, $2, '2024-07-01 09:00:00+00', '2024-07-01 10:00:00+00', true) RETURNING id`, parentID, userID))
+	_, err = db.ExecContext(ctx, `INSERT INTO time_entry_pause (time_entry_id, started_at, ended_at)
+VALUES ($1, '2024-07-01 09:20:00+00', '2024-07-01 09:30:00+00')`, entryID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO timesheet (project_id, user_id, week_start, status)
+VALUES ($1, $2, '2024-07-01', 'approved')`, projectID, userID)
+	require.NoError(t, err)
+	// Non-billable hour of the subtask next week, which is the next day in Moscow
+	_, err = db.ExecContext(ctx, `INSERT INTO time_entry (task_id, user_id, started_at, ended_at, billable)
+VALUES ($1, $2, '2024-07-08 22:30:00+00', '2024-07-08 23:30:00+00', false)`, childID, userID)
+	require.NoError(t, err)
+
+	reportsRepo := NewReportsRepository(db)
+	report := func(filter utils.ReportFilter, groupBy string) []*models.ReportRow {
+		filter.From, filter.To = "2024-07-01", "2024-07-31"
+		rows, err := reportsRepo.Get(ctx, userID, false, &utils.ReportQuery{ReportFilter: filter, GroupBy: []string{groupBy}})
+		require.NoError(t, err)
+		return rows
+	}
+	parent, child := strconv.FormatInt(parentID, 10), strconv.FormatInt(childID, 10)
+
+	assert.Equal(t, []*models.ReportRow{
+		{Key1: child, Name1: "Child", TotalSeconds: 3600},
+		{Key1: parent, Name1: "Parent", TotalSeconds: 3000, BillableSeconds: 3000},
+	}, report(utils.ReportFilter{Timezone: "UTC"}, utils.ReportByTask))
+
+	assert.Equal(t, []*models.ReportRow{
+		{Key1: parent, Name1: "Parent", TotalSeconds: 6600, BillableSeconds: 3000},
+	}, report(utils.ReportFilter{Timezone: "UTC", Rollup: true}, utils.ReportByTask))
+
+	labels := report(utils.ReportFilter{Timezone: "UTC"}, utils.ReportByLabel)
+	require.Len(t, labels, 3)
+	assert.Equal(t, []string{"", "Backend", "Frontend"}, []string{labels[0].Name1, labels[1].Name1, labels[2].Name1})
+	assert.Equal(t, []int64{3600, 1500, 1500}, []int64{labels[0].TotalSeconds, labels[1].TotalSeconds,
+		labels[2].TotalSeconds})
+
+	assert.Equal(t, []*models.ReportRow{
+		{Key1: "2024-07-01", Name1: "2024-07-01", TotalSeconds: 3000, BillableSeconds: 3000},
+		{Key1: "2024-07-09", Name1: "2024-07-09", TotalSeconds: 3600},
+	}, report(utils.ReportFilter{Timezone: "Europe/Moscow"}, utils.ReportByDay))
+
+	assert.Equal(t, []*models.ReportRow{
+		{Key1: strconv.FormatInt(projectID, 10), Name1: "Project", TotalSeconds: 3000, BillableSeconds: 3000},
+	}, report(utils.ReportFilter{Timezone: "UTC", Approved: true}, utils.ReportByProject))
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
 	"github.com/jmoiron/sqlx"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -25,7 +26,8 @@ func (c projectsRepo) Create(ctx context.Context, project *models.Project) (*mod
 
 	var createdProject models.Project
 	if err := c.db.QueryRowxContext(ctx, createProjectQuery, project.Name, project.Description,
-		project.CreatorID).StructScan(&createdProject); err != nil {
+		project.CreatorID, project.Billable, project.StartInProgress, project.WarnBlockedStart).
+		StructScan(&createdProject); err != nil {
 		return nil, err
 	}
 	if err := c.AddMember(ctx, createdProject.ID, createdProject.CreatorID); err != nil {
@@ -65,7 +67,9 @@ func (c projectsRepo) Update(ctx context.Context, updatedProject *models.Project
 	defer span.End()
 
 	return updatedProject, c.db.QueryRowxContext(ctx, updateProjectQuery, updatedProject.Name, updatedProject.Description,
-		updatedProject.CreatorID, updatedProject.ID).StructScan(updatedProject)
+		updatedProject.CreatorID, updatedProject.Billable, updatedProject.StartInProgress, updatedProject.WarnBlockedStart,
+		updatedProject.ID).
+		StructScan(updatedProject)
 }
 
 func (c projectsRepo) IsMember(ctx context.Context, projectID, userID int64) error {
@@ -160,11 +164,16 @@ func (c projectsRepo) GetMembers(ctx context.Context, projectID int64) ([]*model
 	return users, nil
 }
 
-func (c projectsRepo) GetMemberProductivity(ctx context.Context, projectID, userID int64) ([]models.UserProductivity, error) {
+func (c projectsRepo) GetMemberProductivity(ctx context.Context, projectID, userID int64, query *utils.ProductivityQuery) ([]models.UserProductivity, error) {
 	ctx, span := c.tracer.Start(ctx, "projectsRepo.GetMemberProductivity")
 	defer span.End()
 
-	rows, err := c.db.QueryxContext(ctx, getProjectMemberProductivityQuery, projectID, userID)
+	productivityQuery := getProjectMemberProductivityQuery
+	if query.GroupBy == utils.GroupByTag {
+		productivityQuery = getProjectMemberProductivityByTagQuery
+	}
+	rows, err := c.db.QueryxContext(ctx, productivityQuery, projectID, userID, query.TagID,
+		query.Approved)
 	if err != nil {
 		return nil, err
 	}
@@ -173,8 +182,11 @@ func (c projectsRepo) GetMemberProductivity(ctx context.Context, projectID, user
 	var productivity = make([]models.UserProductivity, 0, 10)
 	for rows.Next() {
 		result := struct {
-			TaskID       int64   `db:"task_id"`
-			TotalSeconds float64 `db:"total_seconds"`
+			TaskID          int64         `db:"task_id"`
+			TagID           sql.NullInt64 `db:"tag_id"`
+			TotalSeconds    float64       `db:"total_seconds"`
+			BillableSeconds float64       `db:"billable_seconds"`
+			Amount          float64       `db:"amount"`
 		}{}
 		if err = rows.StructScan(&result); err != nil {
 			return nil, err
@@ -182,9 +194,12 @@ func (c projectsRepo) GetMemberProductivity(ctx context.Context, projectID, user
 		hours, minutes := getHoursMinutes(int(result.TotalSeconds))
 
 		productivity = append(productivity, models.UserProductivity{
-			TaskID:       result.TaskID,
-			SpentHours:   hours,
-			SpentMinutes: minutes,
+			TaskID:          result.TaskID,
+			TagID:           result.TagID.Int64,
+			SpentHours:      hours,
+			SpentMinutes:    minutes,
+			BillableSeconds: int(result.BillableSeconds),
+			Amount:          result.Amount,
 		})
 	}
 	if err = rows.Err(); err != nil {
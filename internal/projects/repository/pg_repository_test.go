@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -20,7 +21,8 @@ func TestProjectsRepo_Create(t *testing.T) {
 	project := getTestProject()
 
 	mock.ExpectQuery(createProjectQuery).
-		WithArgs(project.Name, project.Description, project.CreatorID).
+		WithArgs(project.Name, project.Description, project.CreatorID, project.Billable, project.StartInProgress,
+			project.WarnBlockedStart).
 		WillReturnRows(sqlmock.NewRows(project.Columns()).AddRow(project.Fields()...))
 	mock.ExpectExec(addProjectMemberQuery).
 		WithArgs(project.ID, project.CreatorID).WillReturnResult(driver.ResultNoRows).WillReturnError(nil)
@@ -109,17 +111,19 @@ func TestProjectsRepo_GetMemberProductivity(t *testing.T) {
 	project := getTestProject()
 	var userID int64 = 123
 
-	mock.ExpectQuery(getProjectMemberProductivityQuery).WithArgs(project.ID, userID).
+	mock.ExpectQuery(getProjectMemberProductivityQuery).WithArgs(project.ID, userID, 0, false).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"task_id", "total_seconds"}).
-				AddRow(1, 90*60).
-				AddRow(2, 15*60),
+			sqlmock.NewRows([]string{"task_id", "total_seconds", "billable_seconds", "amount"}).
+				AddRow(1, 90*60, 60*60, 25.5).
+				AddRow(2, 15*60, 0, 0),
 		)
 	needProductivity := []models.UserProductivity{
 		{
-			TaskID:       1,
-			SpentHours:   1,
-			SpentMinutes: 30,
+			TaskID:          1,
+			SpentHours:      1,
+			SpentMinutes:    30,
+			BillableSeconds: 60 * 60,
+			Amount:          25.5,
 		},
 		{
 			TaskID:       2,
@@ -128,7 +132,30 @@ func TestProjectsRepo_GetMemberProductivity(t *testing.T) {
 		},
 	}
 
-	gotProductivity, err := projectRepo.GetMemberProductivity(context.Background(), project.ID, userID)
+	gotProductivity, err := projectRepo.GetMemberProductivity(context.Background(), project.ID, userID,
+		&utils.ProductivityQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, needProductivity, gotProductivity)
+
+	// Entries without tags are grouped into zero tag
+	mock.ExpectQuery(getProjectMemberProductivityByTagQuery).WithArgs(project.ID, userID, 0, true).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tag_id", "total_seconds", "billable_seconds", "amount"}).
+				AddRow(3, 60*60, 0, 0).
+				AddRow(nil, 5*60, 0, 0),
+		)
+	needProductivity = []models.UserProductivity{
+		{
+			TagID:      3,
+			SpentHours: 1,
+		},
+		{
+			SpentMinutes: 5,
+		},
+	}
+
+	gotProductivity, err = projectRepo.GetMemberProductivity(context.Background(), project.ID, userID,
+		&utils.ProductivityQuery{GroupBy: utils.GroupByTag, Approved: true})
 	assert.Nil(t, err)
 	assert.Equal(t, needProductivity, gotProductivity)
 }
@@ -221,13 +248,15 @@ func TestProjectsRepo_Update(t *testing.T) {
 
 	project := getTestProject()
 
-	mock.ExpectQuery(updateProjectQuery).WithArgs(project.Name, project.Description, project.CreatorID, project.ID).
+	mock.ExpectQuery(updateProjectQuery).WithArgs(project.Name, project.Description, project.CreatorID, project.Billable,
+		project.StartInProgress, project.WarnBlockedStart, project.ID).
 		WillReturnRows(sqlmock.NewRows(project.Columns()).AddRow(project.Fields()...))
 	gotProject, err := projectRepo.Update(context.Background(), project)
 	assert.Nil(t, err)
 	assert.Equal(t, project, gotProject)
 
-	mock.ExpectQuery(updateProjectQuery).WithArgs(project.Name, project.Description, project.CreatorID, project.ID).
+	mock.ExpectQuery(updateProjectQuery).WithArgs(project.Name, project.Description, project.CreatorID, project.Billable,
+		project.StartInProgress, project.WarnBlockedStart, project.ID).
 		WillReturnError(sql.ErrNoRows)
 	gotProject, err = projectRepo.Update(context.Background(), project)
 	assert.NotNil(t, gotProject)
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type statusesRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewStatusesRepository(db *sqlx.DB) projects.StatusesRepository {
+	return statusesRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (s statusesRepository) Get(ctx context.Context, projectID int64) ([]*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.Get")
+	defer span.End()
+
+	rows, err := s.db.QueryxContext(ctx, selectTaskStatusesQuery, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := make([]*models.TaskStatus, 0, 4)
+	for rows.Next() {
+		var status models.TaskStatus
+		if err = rows.StructScan(&status); err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, &status)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+func (s statusesRepository) GetByID(ctx context.Context, projectID, statusID int64) (*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.GetByID")
+	defer span.End()
+
+	var status models.TaskStatus
+	if err := s.db.GetContext(ctx, &status, getTaskStatusQuery, projectID, statusID); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// Create creates the status with its transitions. Position is the end of the workflow when nil
+func (s statusesRepository) Create(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.Create")
+	defer span.End()
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err = tx.GetContext(ctx, &status.ID, createTaskStatusQuery, status.ProjectID, status.Name, status.Category,
+		position); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, createTaskStatusTransitionsQuery, status.ID, status.ProjectID,
+		status.Transitions); err != nil {
+		return nil, err
+	}
+	if err = tx.GetContext(ctx, status, getTaskStatusQuery, status.ProjectID, status.ID); err != nil {
+		return nil, err
+	}
+	return status, tx.Commit()
+}
+
+// Update changes non-empty fields of the status. Transitions are replaced unless they are nil
+func (s statusesRepository) Update(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.Update")
+	defer span.End()
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, updateTaskStatusQuery, status.ProjectID, status.ID, status.Name, status.Category,
+		position)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	if status.Transitions != nil {
+		if _, err = tx.ExecContext(ctx, deleteTaskStatusTransitionsQuery, status.ID); err != nil {
+			return nil, err
+		}
+		if _, err = tx.ExecContext(ctx, createTaskStatusTransitionsQuery, status.ID, status.ProjectID,
+			status.Transitions); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.GetContext(ctx, status, getTaskStatusQuery, status.ProjectID, status.ID); err != nil {
+		return nil, err
+	}
+	return status, tx.Commit()
+}
+
+// Delete deletes the status which isn't used by tasks
+func (s statusesRepository) Delete(ctx context.Context, projectID, statusID int64) error {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.Delete")
+	defer span.End()
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var count int
+	if err = tx.GetContext(ctx, &count, countTaskStatusTasksQuery, statusID); err != nil {
+		return err
+	}
+	if count != 0 {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.TaskStatusInUse.Error(), count)
+	}
+
+	result, err := tx.ExecContext(ctx, deleteTaskStatusQuery, projectID, statusID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return tx.Commit()
+}
+
+func (s statusesRepository) IsTransitionAllowed(ctx context.Context, fromStatusID, toStatusID int64) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesRepository.IsTransitionAllowed")
+	defer span.End()
+
+	var allowed bool
+	return allowed, s.db.GetContext(ctx, &allowed, isTaskStatusTransitionAllowedQuery, fromStatusID, toStatusID)
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestStatusesRepository_Get(t *testing.T) {
+	statusesRepo, db, mock, err := newMockStatusesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	status := getTestTaskStatus()
+	done := &models.TaskStatus{ID: 4, ProjectID: status.ProjectID, Name: "done", Category: models.TaskStatusDone,
+		Position: 3, Transitions: []int64{}}
+
+	mock.ExpectQuery(selectTaskStatusesQuery).WithArgs(status.ProjectID).
+		WillReturnRows(sqlmock.NewRows(status.Columns()).AddRow(status.Fields()...).AddRow(done.Fields()...))
+
+	statuses, err := statusesRepo.Get(context.Background(), status.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskStatus{status, done}, statuses)
+}
+
+func TestStatusesRepository_Create(t *testing.T) {
+	statusesRepo, db, mock, err := newMockStatusesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	status := getTestTaskStatus()
+	request := &models.TaskStatus{ProjectID: status.ProjectID, Name: status.Name, Category: status.Category,
+		Transitions: status.Transitions}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(createTaskStatusQuery).WithArgs(request.ProjectID, request.Name, request.Category, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(status.ID))
+	mock.ExpectExec(createTaskStatusTransitionsQuery).WithArgs(status.ID, status.ProjectID, status.Transitions).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(getTaskStatusQuery).WithArgs(status.ProjectID, status.ID).
+		WillReturnRows(sqlmock.NewRows(status.Columns()).AddRow(status.Fields()...))
+	mock.ExpectCommit()
+
+	gotStatus, err := statusesRepo.Create(context.Background(), request, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, status, gotStatus)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestStatusesRepository_Update(t *testing.T) {
+	statusesRepo, db, mock, err := newMockStatusesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	status := getTestTaskStatus()
+	position := 1
+
+	// Transitions are kept when they are nil
+	request := &models.TaskStatus{ID: status.ID, ProjectID: status.ProjectID, Name: status.Name}
+	mock.ExpectBegin()
+	mock.ExpectExec(updateTaskStatusQuery).WithArgs(status.ProjectID, status.ID, status.Name, "", &position).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getTaskStatusQuery).WithArgs(status.ProjectID, status.ID).
+		WillReturnRows(sqlmock.NewRows(status.Columns()).AddRow(status.Fields()...))
+	mock.ExpectCommit()
+
+	gotStatus, err := statusesRepo.Update(context.Background(), request, &position)
+	assert.Nil(t, err)
+	assert.Equal(t, status, gotStatus)
+
+	request = &models.TaskStatus{ID: status.ID, ProjectID: status.ProjectID, Transitions: status.Transitions}
+	mock.ExpectBegin()
+	mock.ExpectExec(updateTaskStatusQuery).WithArgs(status.ProjectID, status.ID, "", "", nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteTaskStatusTransitionsQuery).WithArgs(status.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createTaskStatusTransitionsQuery).WithArgs(status.ID, status.ProjectID, status.Transitions).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(getTaskStatusQuery).WithArgs(status.ProjectID, status.ID).
+		WillReturnRows(sqlmock.NewRows(status.Columns()).AddRow(status.Fields()...))
+	mock.ExpectCommit()
+
+	_, err = statusesRepo.Update(context.Background(), request, nil)
+	assert.Nil(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(updateTaskStatusQuery).WithArgs(status.ProjectID, status.ID, "", "", nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err = statusesRepo.Update(context.Background(), &models.TaskStatus{ID: status.ID, ProjectID: status.ProjectID}, nil)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestStatusesRepository_Delete(t *testing.T) {
+	statusesRepo, db, mock, err := newMockStatusesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	status := getTestTaskStatus()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(countTaskStatusTasksQuery).WithArgs(status.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(deleteTaskStatusQuery).WithArgs(status.ProjectID, status.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.Nil(t, statusesRepo.Delete(context.Background(), status.ProjectID, status.ID))
+
+	// Status of the tasks can't be deleted
+	mock.ExpectBegin()
+	mock.ExpectQuery(countTaskStatusTasksQuery).WithArgs(status.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectRollback()
+	err = statusesRepo.Delete(context.Background(), status.ProjectID, status.ID)
+	assert.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestStatusesRepository_IsTransitionAllowed(t *testing.T) {
+	statusesRepo, db, mock, err := newMockStatusesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var fromStatusID, toStatusID int64 = 2, 4
+
+	mock.ExpectQuery(isTaskStatusTransitionAllowedQuery).WithArgs(fromStatusID, toStatusID).
+		WillReturnRows(sqlmock.NewRows([]string{"allowed"}).AddRow(false))
+
+	allowed, err := statusesRepo.IsTransitionAllowed(context.Background(), fromStatusID, toStatusID)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+}
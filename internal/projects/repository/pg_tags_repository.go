@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tagsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewTagsRepository(db *sqlx.DB) projects.TagsRepository {
+	return tagsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (t tagsRepository) Get(ctx context.Context, projectID int64) ([]*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsRepository.Get")
+	defer span.End()
+
+	rows, err := t.db.QueryxContext(ctx, selectTagsQuery, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]*models.Tag, 0, 10)
+	for rows.Next() {
+		var tag models.Tag
+		if err = rows.StructScan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (t tagsRepository) Create(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsRepository.Create")
+	defer span.End()
+
+	return tag, t.db.QueryRowxContext(ctx, createTagQuery, tag.ProjectID, tag.Name).StructScan(tag)
+}
+
+func (t tagsRepository) Update(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsRepository.Update")
+	defer span.End()
+
+	return tag, t.db.QueryRowxContext(ctx, updateTagQuery, tag.Name, tag.ID, tag.ProjectID).StructScan(tag)
+}
+
+func (t tagsRepository) Delete(ctx context.Context, projectID, tagID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tagsRepository.Delete")
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, deleteTagQuery, tagID, projectID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
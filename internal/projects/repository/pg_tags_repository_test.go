@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTagsRepository_Get(t *testing.T) {
+	tagsRepo, db, mock, err := newMockTagsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	tag := getTestTag()
+
+	mock.ExpectQuery(selectTagsQuery).WithArgs(tag.ProjectID).
+		WillReturnRows(sqlmock.NewRows(tag.Columns()).AddRow(tag.Fields()...))
+
+	gotTags, err := tagsRepo.Get(context.Background(), tag.ProjectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Tag{tag}, gotTags)
+}
+
+func TestTagsRepository_Create(t *testing.T) {
+	tagsRepo, db, mock, err := newMockTagsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	tag := getTestTag()
+
+	mock.ExpectQuery(createTagQuery).WithArgs(tag.ProjectID, tag.Name).
+		WillReturnRows(sqlmock.NewRows(tag.Columns()).AddRow(tag.Fields()...))
+
+	gotTag, err := tagsRepo.Create(context.Background(), tag)
+	assert.Nil(t, err)
+	assert.Equal(t, tag, gotTag)
+}
+
+func TestTagsRepository_Update(t *testing.T) {
+	tagsRepo, db, mock, err := newMockTagsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	tag := getTestTag()
+
+	mock.ExpectQuery(updateTagQuery).WithArgs(tag.Name, tag.ID, tag.ProjectID).
+		WillReturnRows(sqlmock.NewRows(tag.Columns()).AddRow(tag.Fields()...))
+	gotTag, err := tagsRepo.Update(context.Background(), tag)
+	assert.Nil(t, err)
+	assert.Equal(t, tag, gotTag)
+
+	// Tag of another project
+	mock.ExpectQuery(updateTagQuery).WithArgs(tag.Name, tag.ID, tag.ProjectID).WillReturnError(sql.ErrNoRows)
+	_, err = tagsRepo.Update(context.Background(), tag)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestTagsRepository_Delete(t *testing.T) {
+	tagsRepo, db, mock, err := newMockTagsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	tag := getTestTag()
+
+	mock.ExpectExec(deleteTagQuery).WithArgs(tag.ID, tag.ProjectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, tagsRepo.Delete(context.Background(), tag.ProjectID, tag.ID))
+
+	mock.ExpectExec(deleteTagQuery).WithArgs(tag.ID, tag.ProjectID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, tagsRepo.Delete(context.Background(), tag.ProjectID, tag.ID), sql.ErrNoRows)
+}
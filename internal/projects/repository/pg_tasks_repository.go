@@ -3,12 +3,22 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"github.com/armanokka/time_tracker/config"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/rank"
+	"github.com/armanokka/time_tracker/pkg/utils"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"strconv"
+	"time"
 )
 
 type tasksRepository struct {
@@ -20,30 +30,112 @@ func NewTasksRepository(db *sqlx.DB) projects.TasksRepository {
 	return tasksRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
 }
 
-func (t tasksRepository) Get(ctx context.Context, projectID int64) ([]*models.Task, error) {
+// Get returns the page of filtered tasks of the project and the number of tasks matching filters
+func (t tasksRepository) Get(ctx context.Context, projectID int64, query *utils.TasksQuery) (utils.TasksQueryResponse, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksRepository.Get")
 	defer span.End()
 
+	column, _ := query.GetSort()
+	sort := query.Sort
+	if sort == "" {
+		sort = column
+	}
+	var cursorValue interface{}
+	var cursorID int64
+	if query.Cursor != "" {
+		cursor, err := decodeTaskCursor(query.Cursor, sort)
+		if err != nil {
+			return utils.TasksQueryResponse{}, err
+		}
+		cursorValue, cursorID = cursor.Value, cursor.ID
+	}
+
+	args := []interface{}{projectID, pq.Array(query.StatusIDs), query.Finished, query.AssigneeID, query.Search,
+		query.CreatedFrom, query.CreatedTo, query.UpdatedFrom, query.UpdatedTo, query.ParentID,
+		pq.Array(query.LabelIDs), query.LabelMatch == utils.LabelMatchAll}
 	var totalCount int
-	if err := t.db.GetContext(ctx, &totalCount, getTotalTasks, projectID); err != nil {
-		return nil, err
+	if err := t.db.GetContext(ctx, &totalCount, getTotalTasks, args...); err != nil {
+		return utils.TasksQueryResponse{}, err
 	}
 
-	rows, err := t.db.QueryxContext(ctx, selectTasks, projectID)
+	// One more task tells whether there is the next page
+	limit := query.GetLimit()
+	rows, err := t.db.QueryxContext(ctx, selectTasksQueries[sort], append(args, cursorValue, cursorID, limit+1)...)
 	if err != nil {
-		return nil, err
+		return utils.TasksQueryResponse{}, err
 	}
 	defer rows.Close()
 
-	results := make([]*models.Task, 0, totalCount)
+	tasks := make([]*models.Task, 0, limit+1)
 	for rows.Next() {
 		var task models.Task
 		if err = rows.StructScan(&task); err != nil {
-			return nil, err
+			return utils.TasksQueryResponse{}, err
 		}
-		results = append(results, &task)
+		tasks = append(tasks, &task)
+	}
+	if err = rows.Err(); err != nil {
+		return utils.TasksQueryResponse{}, err
+	}
+
+	response := utils.TasksQueryResponse{TotalCount: totalCount}
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+		response.NextCursor = encodeTaskCursor(tasks[limit-1], sort, column)
+	}
+	response.Tasks, response.Count = tasks, len(tasks)
+	return response, nil
+}
+
+// taskCursor is the sort value and id of the last task of the page
+type taskCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    int64  `json:"id"`
+}
+
+func encodeTaskCursor(task *models.Task, sort, column string) string {
+	cursor := taskCursor{Sort: sort, ID: task.ID}
+	switch column {
+	case utils.TasksSortByID:
+		cursor.Value = strconv.FormatInt(task.ID, 10)
+	case utils.TasksSortByName:
+		cursor.Value = task.Name
+	case utils.TasksSortByCreatedAt:
+		cursor.Value = task.CreatedAt.Format(time.RFC3339Nano)
+	case utils.TasksSortByUpdatedAt:
+		cursor.Value = task.UpdatedAt.Format(time.RFC3339Nano)
+	case utils.TasksSortByPriority:
+		cursor.Value = strconv.Itoa(task.Priority)
+	case utils.TasksSortByBoard:
+		cursor.Value = fmt.Sprintf("%d/%s", task.StatusID, task.Rank)
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeTaskCursor refuses malformed cursors and cursors of another sort order
+func decodeTaskCursor(s, sort string) (*taskCursor, error) {
+	var cursor taskCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &cursor)
 	}
-	return results, nil
+	if err != nil || cursor.Sort != sort {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.BadQueryParams.Error(), "invalid cursor")
+	}
+	return &cursor, nil
+}
+
+func (t tasksRepository) GetByID(ctx context.Context, taskID int64) (*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetByID")
+	defer span.End()
+
+	var task models.Task
+	if err := t.db.GetContext(ctx, &task, getTaskByIDQuery, taskID); err != nil {
+		return nil, err
+	}
+	return &task, nil
 }
 
 func (t tasksRepository) Create(ctx context.Context, task *models.Task) (*models.Task, error) {
@@ -51,15 +143,245 @@ func (t tasksRepository) Create(ctx context.Context, task *models.Task) (*models
 	defer span.End()
 
 	return task, t.db.QueryRowxContext(ctx, createTaskQuery, task.Name, task.Description,
-		task.ProjectID).StructScan(task)
+		task.ProjectID, task.Billable, task.EstimateSeconds, task.StatusID, task.ParentID, task.StartAt,
+		task.DueAt, task.Priority).StructScan(task)
 }
 
+// Update changes the task. Parent change is serialized with other parent changes of the project
+// and refused if it makes a cycle
 func (t tasksRepository) Update(ctx context.Context, task *models.Task) (*models.Task, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksRepository.Update")
 	defer span.End()
 
-	return task, t.db.QueryRowxContext(ctx, updateTaskQuery, task.Name, task.Description,
-		task.ID).StructScan(task)
+	if task.ParentID == nil || *task.ParentID == 0 {
+		return task, t.db.QueryRowxContext(ctx, updateTaskQuery, task.Name, task.Description,
+			task.Billable, task.EstimateSeconds, task.ParentID, task.ClearStartAt, task.StartAt, task.ClearDueAt,
+			task.DueAt, task.Priority, task.ID).StructScan(task)
+	}
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err = updateTask(ctx, tx, task); err != nil {
+		return nil, err
+	}
+	return task, tx.Commit()
+}
+
+// updateTask changes the task in the transaction. Parent change locks the task tree of the project
+// and is checked against cycles after the update
+func updateTask(ctx context.Context, tx *sqlx.Tx, task *models.Task) error {
+	moved := task.ParentID != nil && *task.ParentID != 0
+	if moved {
+		if _, err := tx.ExecContext(ctx, lockTaskTreeQuery, task.ID); err != nil {
+			return err
+		}
+	}
+	if err := tx.QueryRowxContext(ctx, updateTaskQuery, task.Name, task.Description, task.Billable,
+		task.EstimateSeconds, task.ParentID, task.ClearStartAt, task.StartAt, task.ClearDueAt, task.DueAt, task.Priority,
+		task.ID).StructScan(task); err != nil {
+		return err
+	}
+	if !moved {
+		return nil
+	}
+	var cycle bool
+	if err := tx.GetContext(ctx, &cycle, isSubtaskQuery, task.ID, task.ID); err != nil {
+		return err
+	}
+	if cycle {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskParent.Error(),
+			"parent task is a subtask of the task")
+	}
+	return nil
+}
+
+// Move places the task right after or before the sibling. Only the rank of the task is changed,
+// the rank between the sibling and its neighbour is generated
+func (t tasksRepository) Move(ctx context.Context, taskID, siblingID int64, after bool) (*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.Move")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, lockTaskStatusQuery, siblingID); err != nil {
+		return nil, err
+	}
+	query := moveTaskBeforeQuery
+	if after {
+		query = moveTaskAfterQuery
+	}
+	var bounds struct {
+		StatusID int64  `db:"status_id"`
+		Prev     string `db:"prev"`
+		Next     string `db:"next"`
+	}
+	if err = tx.GetContext(ctx, &bounds, query, siblingID, taskID); err != nil {
+		return nil, err
+	}
+	taskRank, err := rank.Between(bounds.Prev, bounds.Next)
+	if err != nil {
+		return nil, err
+	}
+
+	var task models.Task
+	if err = tx.QueryRowxContext(ctx, setTaskRankQuery, taskID, taskRank, bounds.StatusID).StructScan(&task); err != nil {
+		return nil, err
+	}
+	return &task, tx.Commit()
+}
+
+func (t tasksRepository) GetEstimates(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetEstimates")
+	defer span.End()
+
+	return t.selectEstimates(ctx, selectTaskEstimatesQuery, projectID)
+}
+
+func (t tasksRepository) GetOverEstimate(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetOverEstimate")
+	defer span.End()
+
+	return t.selectEstimates(ctx, selectOverEstimateTasksQuery, projectID)
+}
+
+func (t tasksRepository) selectEstimates(ctx context.Context, query string, projectID int64) ([]*models.TaskEstimate, error) {
+	rows, err := t.db.QueryxContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estimates := make([]*models.TaskEstimate, 0)
+	for rows.Next() {
+		estimate := &models.TaskEstimate{}
+		if err = rows.StructScan(estimate); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return estimates, nil
+}
+
+// SetStatus updates the task and moves it to task.StatusID in one transaction, running entries of the task
+// are stopped in the same transaction if asked
+func (t tasksRepository) SetStatus(ctx context.Context, task *models.Task, stopEntries bool) (*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.SetStatus")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	statusID := task.StatusID
+	if err = updateTask(ctx, tx, task); err != nil {
+		return nil, err
+	}
+	if err = tx.QueryRowxContext(ctx, setTaskStatusQuery, task.ID, statusID).StructScan(task); err != nil {
+		return nil, err
+	}
+	if stopEntries {
+		if _, err = tx.ExecContext(ctx, stopTaskEntriesQuery, task.ID); err != nil {
+			return nil, err
+		}
+	}
+	return task, tx.Commit()
+}
+
+// Bulk applies the batch in one transaction: creates, updates, member changes and deletes in this order.
+// Created and updated tasks are scanned into the items. Updated task moved to done status has running entries stopped.
+// Parent changes are checked against cycles and maxDepth after all updates are applied.
+// The first failed item rolls back the batch and is returned as *utils.BulkTaskError
+func (t tasksRepository) Bulk(ctx context.Context, batch *utils.BulkTasksRequest, maxDepth int) error {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.Bulk")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	exec := func(query string, args ...interface{}) error {
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	}
+
+	for i, task := range batch.Create {
+		if err = tx.QueryRowxContext(ctx, createTaskQuery, task.Name, task.Description, task.ProjectID, task.Billable,
+			task.EstimateSeconds, task.StatusID, task.ParentID, task.StartAt, task.DueAt,
+			task.Priority).StructScan(task); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkCreate, Index: i, Err: err}
+		}
+	}
+	for i, task := range batch.Update {
+		statusID := task.StatusID
+		err = updateTask(ctx, tx, task)
+		if err == nil && statusID != 0 && statusID != task.StatusID {
+			err = tx.QueryRowxContext(ctx, setTaskStatusQuery, task.ID, statusID).StructScan(task)
+			if err == nil {
+				_, err = tx.ExecContext(ctx, stopDoneTaskEntriesQuery, task.ID)
+			}
+		}
+		if err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkUpdate, Index: i, Err: err}
+		}
+	}
+	// Items are checked one by one before the batch, so chained parent changes are checked here
+	for i, task := range batch.Update {
+		if task.ParentID == nil || *task.ParentID == 0 {
+			continue
+		}
+		var depth, height int
+		if err = tx.GetContext(ctx, &depth, getTaskDepthQuery, task.ID); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkUpdate, Index: i, Err: err}
+		}
+		if err = tx.GetContext(ctx, &height, getSubtasksHeightQuery, task.ID); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkUpdate, Index: i, Err: err}
+		}
+		if depth+height > maxDepth {
+			return &utils.BulkTaskError{Op: utils.BulkUpdate, Index: i, Err: httpErrors.NewRestError(
+				http.StatusBadRequest, httpErrors.TaskDepthExceeded.Error(), fmt.Sprintf("max depth is %d", maxDepth))}
+		}
+	}
+	for i, member := range batch.AddMembers {
+		if err = exec(addTaskMemberQuery, member.TaskID, member.UserID); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkAddMembers, Index: i, Err: err}
+		}
+	}
+	for i, member := range batch.DeleteMembers {
+		if err = exec(deleteTaskMemberQuery, member.TaskID, member.UserID); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkDeleteMembers, Index: i, Err: err}
+		}
+	}
+	for i, taskID := range batch.Delete {
+		if err = exec(deleteTaskQuery, taskID); err != nil {
+			return &utils.BulkTaskError{Op: utils.BulkDelete, Index: i, Err: err}
+		}
+	}
+	return tx.Commit()
 }
 
 func (t tasksRepository) Delete(ctx context.Context, taskID int64) error {
@@ -80,19 +402,54 @@ func (t tasksRepository) Delete(ctx context.Context, taskID int64) error {
 	return err
 }
 
-func (t tasksRepository) Start(ctx context.Context, taskID, userID int64) error {
+// Start checks running entries of the user according to the timer policy and starts the task in one transaction.
+// Concurrent starts of the same user are serialized by advisory lock. Todo task is moved to in progress status
+// if the project asks for it
+func (t tasksRepository) Start(ctx context.Context, entry *models.TimeEntry, policy string) error {
 	ctx, span := t.tracer.Start(ctx, "tasksRepository.Start")
 	defer span.End()
 
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, lockUserTimeEntriesQuery, entry.UserID); err != nil {
+		return err
+	}
+
 	var count int
-	if err := t.db.GetContext(ctx, &count, getActiveUserTasksQuery, userID, taskID); err != nil {
+	if policy == config.TimerPolicyGlobal {
+		err = tx.GetContext(ctx, &count, getActiveUserTimeEntriesQuery, entry.UserID)
+	} else {
+		err = tx.GetContext(ctx, &count, getActiveUserTasksQuery, entry.UserID, entry.TaskID)
+	}
+	if err != nil {
 		return err
 	}
 	if count != 0 {
-		return fmt.Errorf("task already started")
+		return httpErrors.TimerAlreadyStarted
+	}
+
+	if err = tx.GetContext(ctx, &entry.ID, startTaskQuery, entry.TaskID, entry.UserID, entry.Description,
+		entry.Billable); err != nil {
+		return err
 	}
+	if err = setTimeEntryTags(ctx, tx, entry.ID, entry.TagIDs); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, startTaskInProgressQuery, entry.TaskID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (t tasksRepository) Stop(ctx context.Context, taskID, userID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.Stop")
+	defer span.End()
 
-	result, err := t.db.ExecContext(ctx, startTaskQuery, taskID, userID)
+	result, err := t.db.ExecContext(ctx, endTaskQuery, taskID, userID)
 	if err != nil {
 		return err
 	}
@@ -106,11 +463,12 @@ func (t tasksRepository) Start(ctx context.Context, taskID, userID int64) error
 	return err
 }
 
-func (t tasksRepository) Stop(ctx context.Context, taskID, userID int64) error {
-	ctx, span := t.tracer.Start(ctx, "tasksRepository.Stop")
+// Pause opens pause segment of the running entry. Second pause of the same entry violates unique index
+func (t tasksRepository) Pause(ctx context.Context, taskID, userID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.Pause")
 	defer span.End()
 
-	result, err := t.db.ExecContext(ctx, endTaskQuery, taskID, userID)
+	result, err := t.db.ExecContext(ctx, pauseTaskQuery, taskID, userID)
 	if err != nil {
 		return err
 	}
@@ -121,7 +479,25 @@ func (t tasksRepository) Stop(ctx context.Context, taskID, userID int64) error {
 	if rowsAffected == 0 {
 		return sql.ErrNoRows
 	}
-	return err
+	return nil
+}
+
+func (t tasksRepository) Resume(ctx context.Context, taskID, userID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.Resume")
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, resumeTaskQuery, taskID, userID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
 }
 
 func (t tasksRepository) GetMembers(ctx context.Context, taskID int64) ([]*models.User, error) {
@@ -154,6 +530,149 @@ func (t tasksRepository) GetMembers(ctx context.Context, taskID int64) ([]*model
 	return users, nil
 }
 
+func (t tasksRepository) GetSubtasks(ctx context.Context, taskID int64) ([]*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetSubtasks")
+	defer span.End()
+
+	tasks := make([]*models.Task, 0)
+	if err := t.db.SelectContext(ctx, &tasks, selectChildTasksQuery, taskID); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (t tasksRepository) GetLabels(ctx context.Context, taskID int64) ([]*models.Label, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetLabels")
+	defer span.End()
+
+	labels := make([]*models.Label, 0)
+	if err := t.db.SelectContext(ctx, &labels, selectTaskLabelsQuery, taskID); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// GetDepth returns the number of ancestors of the task
+func (t tasksRepository) GetDepth(ctx context.Context, taskID int64) (int, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetDepth")
+	defer span.End()
+
+	var depth int
+	return depth, t.db.GetContext(ctx, &depth, getTaskDepthQuery, taskID)
+}
+
+// GetHeight returns the number of subtask levels below the task
+func (t tasksRepository) GetHeight(ctx context.Context, taskID int64) (int, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetHeight")
+	defer span.End()
+
+	var height int
+	return height, t.db.GetContext(ctx, &height, getSubtasksHeightQuery, taskID)
+}
+
+// IsSubtask tells whether subtaskID is a subtask of the task at any level
+func (t tasksRepository) IsSubtask(ctx context.Context, taskID, subtaskID int64) (bool, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.IsSubtask")
+	defer span.End()
+
+	var exists bool
+	return exists, t.db.GetContext(ctx, &exists, isSubtaskQuery, taskID, subtaskID)
+}
+
+// GetAncestorIDs returns ids of the task parent, its parent and so on up to the top-level task
+func (t tasksRepository) GetAncestorIDs(ctx context.Context, taskID int64) ([]int64, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetAncestorIDs")
+	defer span.End()
+
+	ids := make([]int64, 0)
+	if err := t.db.SelectContext(ctx, &ids, getTaskAncestorIDsQuery, taskID); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (t tasksRepository) GetMembersTime(ctx context.Context, taskID int64) ([]*models.TaskMemberTime, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetMembersTime")
+	defer span.End()
+
+	membersTime := make([]*models.TaskMemberTime, 0)
+	if err := t.db.SelectContext(ctx, &membersTime, getTaskMembersTimeQuery, taskID); err != nil {
+		return nil, err
+	}
+	return membersTime, nil
+}
+
+func (t tasksRepository) GetRunningEntries(ctx context.Context, taskID int64) ([]*models.ActiveTimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetRunningEntries")
+	defer span.End()
+
+	entries := make([]*models.ActiveTimeEntry, 0)
+	if err := t.db.SelectContext(ctx, &entries, getTaskRunningEntriesQuery, taskID); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (t tasksRepository) GetMemberIDs(ctx context.Context, taskID int64) ([]int64, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetMemberIDs")
+	defer span.End()
+
+	userIDs := make([]int64, 0)
+	if err := t.db.SelectContext(ctx, &userIDs, getTaskMemberIDsQuery, taskID); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// GetDue returns unfinished tasks of the user due within the duration, overdue tasks included
+func (t tasksRepository) GetDue(ctx context.Context, userID int64, within time.Duration) ([]*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.GetDue")
+	defer span.End()
+
+	tasks := make([]*models.Task, 0)
+	if err := t.db.SelectContext(ctx, &tasks, selectDueTasksQuery, userID, int64(within.Seconds())); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ClaimDueReminders marks unfinished tasks due within the duration, which weren't reminded about the due date yet,
+// as reminded and creates the notification of fn for every member of them in the same transaction.
+// It returns amount of reminded tasks
+func (t tasksRepository) ClaimDueReminders(ctx context.Context, before time.Duration,
+	fn func(task *models.Task) (*models.Notification, error)) (int64, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksRepository.ClaimDueReminders")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	tasks := make([]*models.Task, 0)
+	if err = tx.SelectContext(ctx, &tasks, claimDueRemindersQuery, int64(before.Seconds())); err != nil {
+		return 0, err
+	}
+	for _, task := range tasks {
+		userIDs := make([]int64, 0)
+		if err = tx.SelectContext(ctx, &userIDs, getTaskMemberIDsQuery, task.ID); err != nil {
+			return 0, err
+		}
+		notification, err := fn(task)
+		if err != nil {
+			return 0, err
+		}
+		for _, userID := range userIDs {
+			if _, err = tx.ExecContext(ctx, createNotificationQuery, userID, notification.Type, notification.ProjectID,
+				notification.TaskID, notification.Message, notification.Payload); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return int64(len(tasks)), tx.Commit()
+}
+
 func (t tasksRepository) AddMember(ctx context.Context, taskID, userID int64) error {
 	ctx, span := t.tracer.Start(ctx, "tasksRepository.AddMember")
 	defer span.End()
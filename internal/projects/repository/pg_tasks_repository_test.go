@@ -2,12 +2,22 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/config"
 	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"log"
+	"net/http"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestTasksRepository_Create(t *testing.T) {
@@ -16,7 +26,10 @@ func TestTasksRepository_Create(t *testing.T) {
 	defer db.Close()
 
 	task := getTestTask()
-	mock.ExpectQuery(createTaskQuery).WithArgs(task.Name, task.Description, task.ProjectID).WillReturnRows(
+	startAt, dueAt := time.Date(2024, 7, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 7, 10, 18, 0, 0, 0, time.UTC)
+	task.StartAt, task.DueAt = &startAt, &dueAt
+	mock.ExpectQuery(createTaskQuery).WithArgs(task.Name, task.Description, task.ProjectID, task.Billable,
+		task.EstimateSeconds, task.StatusID, task.ParentID, task.StartAt, task.DueAt, task.Priority).WillReturnRows(
 		sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...),
 	)
 
@@ -25,6 +38,437 @@ func TestTasksRepository_Create(t *testing.T) {
 	assert.Equal(t, task, gotTask)
 }
 
+func TestTasksRepository_GetEstimates(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID int64 = 4
+	var estimate, remaining, variance int64 = 3600, 0, 1800
+	estimated := &models.TaskEstimate{TaskID: 1, Name: "Lorem", EstimateSeconds: &estimate, ActualSeconds: 5400,
+		RemainingSeconds: &remaining, VarianceSeconds: &variance}
+	notEstimated := &models.TaskEstimate{TaskID: 2, Name: "Ipsum", ActualSeconds: 600}
+
+	mock.ExpectQuery(selectTaskEstimatesQuery).WithArgs(projectID).WillReturnRows(
+		sqlmock.NewRows(estimated.Columns()).AddRow(estimated.Fields()...).AddRow(notEstimated.Fields()...),
+	)
+	estimates, err := tasksRepo.GetEstimates(context.Background(), projectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskEstimate{estimated, notEstimated}, estimates)
+
+	mock.ExpectQuery(selectOverEstimateTasksQuery).WithArgs(projectID).WillReturnRows(
+		sqlmock.NewRows(estimated.Columns()).AddRow(estimated.Fields()...),
+	)
+	estimates, err = tasksRepo.GetOverEstimate(context.Background(), projectID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskEstimate{estimated}, estimates)
+
+	mock.ExpectQuery(selectOverEstimateTasksQuery).WithArgs(projectID).WillReturnError(fmt.Errorf("some error"))
+	_, err = tasksRepo.GetOverEstimate(context.Background(), projectID)
+	assert.NotNil(t, err)
+}
+
+func TestTasksRepository_GetByID(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+
+	mock.ExpectQuery(getTaskByIDQuery).WithArgs(task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	gotTask, err := tasksRepo.GetByID(context.Background(), task.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, task, gotTask)
+
+	mock.ExpectQuery(getTaskByIDQuery).WithArgs(task.ID).WillReturnError(sql.ErrNoRows)
+	_, err = tasksRepo.GetByID(context.Background(), task.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestTasksRepository_GetMembersTime(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	first := &models.TaskMemberTime{UserID: 10, TotalSeconds: 5400, BillableSeconds: 3600, OwnSeconds: 1800,
+		EntriesCount: 2}
+	second := &models.TaskMemberTime{UserID: 11, TotalSeconds: 600, EntriesCount: 1}
+
+	mock.ExpectQuery(getTaskMembersTimeQuery).WithArgs(task.ID).WillReturnRows(
+		sqlmock.NewRows(first.Columns()).AddRow(first.Fields()...).AddRow(second.Fields()...),
+	)
+	membersTime, err := tasksRepo.GetMembersTime(context.Background(), task.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TaskMemberTime{first, second}, membersTime)
+}
+
+func TestTasksRepository_GetSubtasks(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	parent := getTestTask()
+	subtask := getTestTask()
+	subtask.ID = 2
+	subtask.ParentID = &parent.ID
+
+	mock.ExpectQuery(selectChildTasksQuery).WithArgs(parent.ID).
+		WillReturnRows(sqlmock.NewRows(subtask.Columns()).AddRow(subtask.Fields()...))
+	subtasks, err := tasksRepo.GetSubtasks(context.Background(), parent.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Task{subtask}, subtasks)
+
+	mock.ExpectQuery(getTaskDepthQuery).WithArgs(subtask.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+	depth, err := tasksRepo.GetDepth(context.Background(), subtask.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, depth)
+
+	mock.ExpectQuery(getSubtasksHeightQuery).WithArgs(parent.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+	height, err := tasksRepo.GetHeight(context.Background(), parent.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, height)
+
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(parent.ID, subtask.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	isSubtask, err := tasksRepo.IsSubtask(context.Background(), parent.ID, subtask.ID)
+	assert.Nil(t, err)
+	assert.True(t, isSubtask)
+
+	mock.ExpectQuery(getTaskAncestorIDsQuery).WithArgs(subtask.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(parent.ID))
+	ancestorIDs, err := tasksRepo.GetAncestorIDs(context.Background(), subtask.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{parent.ID}, ancestorIDs)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_GetLabels(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	label := getTestLabel()
+
+	mock.ExpectQuery(selectTaskLabelsQuery).WithArgs(task.ID).
+		WillReturnRows(sqlmock.NewRows(label.Columns()).AddRow(label.Fields()...))
+	labels, err := tasksRepo.GetLabels(context.Background(), task.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Label{label}, labels)
+}
+
+func TestTasksRepository_GetRunningEntries(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	entry.EndedAt = nil
+	running := &models.ActiveTimeEntry{
+		TimeEntry:   *entry,
+		TaskName:    "Lorem",
+		ProjectID:   4,
+		ProjectName: "Some project",
+	}
+
+	mock.ExpectQuery(getTaskRunningEntriesQuery).WithArgs(entry.TaskID).WillReturnRows(
+		sqlmock.NewRows(append(entry.Columns(), "task_name", "project_id", "project_name", "paused")).
+			AddRow(append(entry.Fields(), running.TaskName, running.ProjectID, running.ProjectName, running.Paused)...),
+	)
+	entries, err := tasksRepo.GetRunningEntries(context.Background(), entry.TaskID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.ActiveTimeEntry{running}, entries)
+}
+
+func TestTasksRepository_GetDue(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	dueAt := time.Date(2024, 7, 10, 18, 0, 0, 0, time.UTC)
+	task.DueAt = &dueAt
+	var userID int64 = 10
+
+	mock.ExpectQuery(selectDueTasksQuery).WithArgs(userID, int64(48*60*60)).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	tasks, err := tasksRepo.GetDue(context.Background(), userID, 48*time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Task{task}, tasks)
+
+	mock.ExpectQuery(getTaskMemberIDsQuery).WithArgs(task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(userID).AddRow(11))
+	userIDs, err := tasksRepo.GetMemberIDs(context.Background(), task.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{userID, 11}, userIDs)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_ClaimDueReminders(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	dueAt := time.Date(2024, 7, 10, 18, 0, 0, 0, time.UTC)
+	task.DueAt = &dueAt
+	notification := &models.Notification{Type: models.NotificationDueReminder, ProjectID: &task.ProjectID,
+		TaskID: &task.ID, Message: "Task is due", Payload: []byte(`{}`)}
+	notify := func(got *models.Task) (*models.Notification, error) {
+		assert.Equal(t, task, got)
+		return notification, nil
+	}
+
+	// Tasks are claimed along with notifications of their members
+	mock.ExpectBegin()
+	mock.ExpectQuery(claimDueRemindersQuery).WithArgs(int64(24 * 60 * 60)).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	mock.ExpectQuery(getTaskMemberIDsQuery).WithArgs(task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(10).AddRow(11))
+	for _, userID := range []int64{10, 11} {
+		mock.ExpectExec(createNotificationQuery).WithArgs(userID, notification.Type, notification.ProjectID,
+			notification.TaskID, notification.Message, notification.Payload).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	reminded, err := tasksRepo.ClaimDueReminders(context.Background(), 24*time.Hour, notify)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), reminded)
+
+	// Failed notification releases the claim
+	mock.ExpectBegin()
+	mock.ExpectQuery(claimDueRemindersQuery).WithArgs(int64(24 * 60 * 60)).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	mock.ExpectQuery(getTaskMemberIDsQuery).WithArgs(task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(10))
+	mock.ExpectExec(createNotificationQuery).WithArgs(int64(10), notification.Type, notification.ProjectID,
+		notification.TaskID, notification.Message, notification.Payload).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = tasksRepo.ClaimDueReminders(context.Background(), 24*time.Hour, notify)
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_Update(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var parentID int64 = 5
+	movedTask := *task
+	movedTask.ParentID = &parentID
+
+	// Parent change locks the task tree and is checked against cycles
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskTreeQuery).WithArgs(task.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("", "", nil, nil, &parentID, false, nil, false, nil, 0, task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(movedTask.Fields()...))
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(task.ID, task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectCommit()
+
+	gotTask, err := tasksRepo.Update(context.Background(), &models.Task{ID: task.ID, ParentID: &parentID})
+	assert.Nil(t, err)
+	assert.Equal(t, &movedTask, gotTask)
+
+	// Concurrent parent change made a cycle
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskTreeQuery).WithArgs(task.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("", "", nil, nil, &parentID, false, nil, false, nil, 0, task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(movedTask.Fields()...))
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(task.ID, task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	_, err = tasksRepo.Update(context.Background(), &models.Task{ID: task.ID, ParentID: &parentID})
+	assert.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_SetStatus(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	updatedTask := *task
+	updatedTask.Name = "Dolor"
+	doneTask := updatedTask
+	doneTask.StatusID = 4
+
+	// Fields are updated and running entries are stopped when the task is done
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateTaskQuery).WithArgs("Dolor", "", nil, nil, nil, false, nil, false, nil, 0, task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(updatedTask.Fields()...))
+	mock.ExpectQuery(setTaskStatusQuery).WithArgs(task.ID, doneTask.StatusID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(doneTask.Fields()...))
+	mock.ExpectExec(stopTaskEntriesQuery).WithArgs(task.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	gotTask, err := tasksRepo.SetStatus(context.Background(), &models.Task{ID: task.ID, Name: "Dolor", StatusID: 4}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, &doneTask, gotTask)
+
+	// Failed status change rolls back the fields
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateTaskQuery).WithArgs("Dolor", "", nil, nil, nil, false, nil, false, nil, 0, task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(updatedTask.Fields()...))
+	mock.ExpectQuery(setTaskStatusQuery).WithArgs(task.ID, doneTask.StatusID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err = tasksRepo.SetStatus(context.Background(), &models.Task{ID: task.ID, Name: "Dolor", StatusID: 4}, false)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_Move(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var siblingID int64 = 5
+	boundsColumns := []string{"status_id", "prev", "next"}
+
+	// Placed between the sibling and the next task
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskStatusQuery).WithArgs(siblingID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(moveTaskAfterQuery).WithArgs(siblingID, task.ID).
+		WillReturnRows(sqlmock.NewRows(boundsColumns).AddRow(task.StatusID, "i", "r"))
+	task.Rank = "m"
+	mock.ExpectQuery(setTaskRankQuery).WithArgs(task.ID, task.Rank, task.StatusID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	mock.ExpectCommit()
+
+	gotTask, err := tasksRepo.Move(context.Background(), task.ID, siblingID, true)
+	assert.Nil(t, err)
+	assert.Equal(t, task, gotTask)
+
+	// Sibling is the first task of the column
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskStatusQuery).WithArgs(siblingID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(moveTaskBeforeQuery).WithArgs(siblingID, task.ID).
+		WillReturnRows(sqlmock.NewRows(boundsColumns).AddRow(task.StatusID, "", "i"))
+	task.Rank = "9"
+	mock.ExpectQuery(setTaskRankQuery).WithArgs(task.ID, task.Rank, task.StatusID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	mock.ExpectCommit()
+
+	gotTask, err = tasksRepo.Move(context.Background(), task.ID, siblingID, false)
+	assert.Nil(t, err)
+	assert.Equal(t, task, gotTask)
+
+	// Task of another status isn't moved
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskStatusQuery).WithArgs(siblingID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(moveTaskBeforeQuery).WithArgs(siblingID, task.ID).
+		WillReturnRows(sqlmock.NewRows(boundsColumns).AddRow(3, "", "i"))
+	mock.ExpectQuery(setTaskRankQuery).WithArgs(task.ID, "9", 3).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err = tasksRepo.Move(context.Background(), task.ID, siblingID, false)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_Bulk(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var doneStatusID int64 = 4
+	batch := &utils.BulkTasksRequest{
+		Create:     []*models.Task{{Name: task.Name, Description: task.Description, ProjectID: task.ProjectID, Priority: task.Priority}},
+		Update:     []*models.Task{{ID: task.ID, Name: "Dolor", StatusID: doneStatusID}},
+		Delete:     []int64{3},
+		AddMembers: []*utils.BulkTaskMember{{TaskID: task.ID, UserID: 10}},
+	}
+	updatedTask := *task
+	updatedTask.Name = "Dolor"
+	doneTask := updatedTask
+	doneTask.StatusID = doneStatusID
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(createTaskQuery).WithArgs(task.Name, task.Description, task.ProjectID, nil, nil, int64(0), nil,
+		nil, nil, task.Priority).WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("Dolor", "", nil, nil, nil, false, nil, false, nil, 0, task.ID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(updatedTask.Fields()...))
+	mock.ExpectQuery(setTaskStatusQuery).WithArgs(task.ID, doneStatusID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(doneTask.Fields()...))
+	mock.ExpectExec(stopDoneTaskEntriesQuery).WithArgs(task.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(addTaskMemberQuery).WithArgs(task.ID, int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteTaskQuery).WithArgs(int64(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = tasksRepo.Bulk(context.Background(), batch, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, task, batch.Create[0])
+	assert.Equal(t, &doneTask, batch.Update[0])
+
+	// Parent changes of the batch make a cycle
+	var taskAID, taskBID int64 = 1, 2
+	taskA, taskB := *task, *task
+	taskA.ParentID, taskB.ID, taskB.ParentID = &taskBID, taskBID, &taskAID
+	batch = &utils.BulkTasksRequest{Update: []*models.Task{{ID: taskAID, ParentID: &taskBID}, {ID: taskBID, ParentID: &taskAID}}}
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskTreeQuery).WithArgs(taskAID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("", "", nil, nil, &taskBID, false, nil, false, nil, 0, taskAID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(taskA.Fields()...))
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(taskAID, taskAID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(lockTaskTreeQuery).WithArgs(taskBID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("", "", nil, nil, &taskAID, false, nil, false, nil, 0, taskBID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(taskB.Fields()...))
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(taskBID, taskBID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err = tasksRepo.Bulk(context.Background(), batch, 3)
+	var bulkErr *utils.BulkTaskError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, utils.BulkUpdate, bulkErr.Op)
+	assert.Equal(t, 1, bulkErr.Index)
+	assert.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(bulkErr.Err).Status())
+
+	// Chained parent changes exceed max depth
+	batch = &utils.BulkTasksRequest{Update: []*models.Task{{ID: taskAID, ParentID: &taskBID}}}
+	mock.ExpectBegin()
+	mock.ExpectExec(lockTaskTreeQuery).WithArgs(taskAID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(updateTaskQuery).WithArgs("", "", nil, nil, &taskBID, false, nil, false, nil, 0, taskAID).
+		WillReturnRows(sqlmock.NewRows(task.Columns()).AddRow(taskA.Fields()...))
+	mock.ExpectQuery(isSubtaskQuery).WithArgs(taskAID, taskAID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery(getTaskDepthQuery).WithArgs(taskAID).WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(3))
+	mock.ExpectQuery(getSubtasksHeightQuery).WithArgs(taskAID).WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+	mock.ExpectRollback()
+
+	err = tasksRepo.Bulk(context.Background(), batch, 3)
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, 0, bulkErr.Index)
+	assert.ErrorContains(t, bulkErr, httpErrors.TaskDepthExceeded.Error())
+
+	// Missing task rolls back the batch and its item is reported
+	batch = &utils.BulkTasksRequest{Delete: []int64{2, 3}}
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteTaskQuery).WithArgs(int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteTaskQuery).WithArgs(int64(3)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = tasksRepo.Bulk(context.Background(), batch, 3)
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, utils.BulkDelete, bulkErr.Op)
+	assert.Equal(t, 1, bulkErr.Index)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
 func TestTasksRepository_Delete(t *testing.T) {
 	tasksRepo, db, mock, err := newMockTasksRepo()
 	require.NoError(t, err)
@@ -62,19 +506,336 @@ func TestTasksRepository_Get(t *testing.T) {
 	require.NoError(t, err)
 	defer db.Close()
 
-	task := getTestTask()
-	var projectID int64 = 1
+	first := getTestTask()
+	second := getTestTask()
+	second.ID = 2
+	var projectID int64 = 4
+	finished := false
+	query := &utils.TasksQuery{StatusIDs: []int64{2}, Finished: &finished, Search: "Lor", LabelIDs: []int64{6, 7},
+		LabelMatch: utils.LabelMatchAll, Sort: "-id", Limit: 1}
+	args := []driver.Value{projectID, pq.Array(query.StatusIDs), query.Finished, query.AssigneeID, query.Search,
+		query.CreatedFrom, query.CreatedTo, query.UpdatedFrom, query.UpdatedTo, query.ParentID,
+		pq.Array(query.LabelIDs), true}
 
-	tasks := []*models.Task{task}
+	mock.ExpectQuery(getTotalTasks).WithArgs(args...).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(2),
+	)
+	mock.ExpectQuery(selectTasksQueries["-id"]).WithArgs(append(args, nil, int64(0), 2)...).WillReturnRows(
+		sqlmock.NewRows(second.Columns()).AddRow(second.Fields()...).AddRow(first.Fields()...),
+	)
+	page, err := tasksRepo.Get(context.Background(), projectID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Task{second}, page.Tasks)
+	assert.Equal(t, 1, page.Count)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.NotEmpty(t, page.NextCursor)
 
-	mock.ExpectQuery(getTotalTasks).WithArgs(projectID).WillReturnRows(
-		sqlmock.NewRows([]string{"count"}).AddRow(len(tasks)),
+	query.Cursor = page.NextCursor
+	mock.ExpectQuery(getTotalTasks).WithArgs(args...).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(2),
 	)
-	mock.ExpectQuery(selectTasks).WithArgs(projectID).WillReturnRows(
-		sqlmock.NewRows(task.Columns()).AddRow(task.Fields()...),
+	mock.ExpectQuery(selectTasksQueries["-id"]).WithArgs(append(args, "2", second.ID, 2)...).WillReturnRows(
+		sqlmock.NewRows(first.Columns()).AddRow(first.Fields()...),
 	)
+	page, err = tasksRepo.Get(context.Background(), projectID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Task{first}, page.Tasks)
+	assert.Empty(t, page.NextCursor)
+
+	// Cursor of another sort order is refused
+	query.Sort = "name"
+	_, err = tasksRepo.Get(context.Background(), projectID, query)
+	assert.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
 
-	gotTasks, err := tasksRepo.Get(context.Background(), projectID)
+	// Board order is the default one, cursor keeps status and rank of the last task
+	query.Sort, query.Cursor = "", ""
+	second.Rank = "r"
+	mock.ExpectQuery(getTotalTasks).WithArgs(args...).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(2),
+	)
+	mock.ExpectQuery(selectTasksQueries["board"]).WithArgs(append(args, nil, int64(0), 2)...).WillReturnRows(
+		sqlmock.NewRows(first.Columns()).AddRow(first.Fields()...).AddRow(second.Fields()...),
+	)
+	page, err = tasksRepo.Get(context.Background(), projectID, query)
 	assert.Nil(t, err)
-	assert.Equal(t, tasks, gotTasks)
+	assert.Equal(t, []*models.Task{first}, page.Tasks)
+
+	query.Cursor = page.NextCursor
+	mock.ExpectQuery(getTotalTasks).WithArgs(args...).WillReturnRows(
+		sqlmock.NewRows([]string{"count"}).AddRow(2),
+	)
+	mock.ExpectQuery(selectTasksQueries["board"]).WithArgs(append(args, "2/i", first.ID, 2)...).WillReturnRows(
+		sqlmock.NewRows(second.Columns()).AddRow(second.Fields()...),
+	)
+	page, err = tasksRepo.Get(context.Background(), projectID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Task{second}, page.Tasks)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_Start(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var userID int64 = 9
+	description := "Fixing tests"
+	entry := &models.TimeEntry{TaskID: task.ID, UserID: userID, Description: &description, TagIDs: []int64{3, 4}}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockUserTimeEntriesQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getActiveUserTasksQuery).WithArgs(userID, task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(startTaskQuery).WithArgs(task.ID, userID, description, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectExec(deleteTimeEntryTagsQuery).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(addTimeEntryTagsQuery).WithArgs(5, pq.Array(entry.TagIDs)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(startTaskInProgressQuery).WithArgs(task.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.Nil(t, tasksRepo.Start(context.Background(), entry, config.TimerPolicyProject))
+	assert.Equal(t, int64(5), entry.ID)
+
+	// Tag of another project isn't attached
+	mock.ExpectBegin()
+	mock.ExpectExec(lockUserTimeEntriesQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getActiveUserTasksQuery).WithArgs(userID, task.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(startTaskQuery).WithArgs(task.ID, userID, description, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+	mock.ExpectExec(deleteTimeEntryTagsQuery).WithArgs(6).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(addTimeEntryTagsQuery).WithArgs(6, pq.Array(entry.TagIDs)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+	assert.ErrorIs(t, tasksRepo.Start(context.Background(), entry, config.TimerPolicyProject), sql.ErrNoRows)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockUserTimeEntriesQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getActiveUserTimeEntriesQuery).WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+	err = tasksRepo.Start(context.Background(), &models.TimeEntry{TaskID: task.ID, UserID: userID},
+		config.TimerPolicyGlobal)
+	assert.ErrorIs(t, err, httpErrors.TimerAlreadyStarted)
+	assert.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTasksRepository_StartConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID, taskID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	require.NoError(t, db.GetContext(ctx, &taskID, `INSERT INTO task (name, project_id)
+VALUES ('Task', $1) RETURNING id`, projectID))
+
+	tasksRepo := NewTasksRepository(db)
+
+	const starts = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, starts)
+	for i := 0; i < starts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- tasksRepo.Start(ctx, &models.TimeEntry{TaskID: taskID, UserID: userID}, config.TimerPolicyProject)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var started int
+	for err := range errs {
+		if err == nil {
+			started++
+			continue
+		}
+		assert.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+	}
+	assert.Equal(t, 1, started)
+
+	var running int
+	require.NoError(t, db.GetContext(ctx, &running, `SELECT count(1) FROM time_entry WHERE ended_at IS NULL`))
+	assert.Equal(t, 1, running)
+
+	// Partial unique index rejects the second open entry even without the check
+	_, err := db.ExecContext(ctx, startTaskQuery, taskID, userID, nil, nil)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+}
+
+// TestTaskDetail_Postgres runs every query of the task detail on migrated database
+func TestTaskDetail_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID, taskID, subtaskID, blockerID, labelID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	require.NoError(t, db.GetContext(ctx, &taskID, `INSERT INTO task (name, project_id)
+VALUES ('Task', $1) RETURNING id`, projectID))
+	require.NoError(t, db.GetContext(ctx, &subtaskID, `INSERT INTO task (name, project_id, parent_id)
+VALUES ('Subtask', $1, $2) RETURNING id`, projectID, taskID))
+	require.NoError(t, db.GetContext(ctx, &blockerID, `INSERT INTO task (name, project_id)
+VALUES ('Blocker', $1) RETURNING id`, projectID))
+	require.NoError(t, db.GetContext(ctx, &labelID, `INSERT INTO label (project_id, name)
+VALUES ($1, 'Backend') RETURNING id`, projectID))
+	_, err := db.ExecContext(ctx, `INSERT INTO task_participant (task_id, user_id) VALUES ($1, $2)`, taskID, userID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO task_label (task_id, label_id) VALUES ($1, $2)`, taskID, labelID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO task_dependency (task_id, blocker_id) VALUES ($1, $2)`, taskID, blockerID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO time_entry (task_id, user_id, started_at, ended_at, billable)
+VALUES ($1, $3, '2024-07-01 09:00:00+00', '2024-07-01 10:00:00+00', true),
+       ($2, $3, '2024-07-01 11:00:00+00', '2024-07-01 11:30:00+00', false)`, taskID, subtaskID, userID)
+	require.NoError(t, err)
+
+	tasksRepo := NewTasksRepository(db)
+	members, err := tasksRepo.GetMembers(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, userID, members[0].ID)
+	assert.Empty(t, members[0].Password)
+
+	subtasks, err := tasksRepo.GetSubtasks(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, subtasks, 1)
+	assert.Equal(t, subtaskID, subtasks[0].ID)
+
+	blockers, err := NewDependenciesRepository(db).Get(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, blockers, 1)
+	assert.Equal(t, blockerID, blockers[0].ID)
+	assert.False(t, blockers[0].Done)
+
+	labels, err := tasksRepo.GetLabels(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, labels, 1)
+	assert.Equal(t, labelID, labels[0].ID)
+
+	membersTime, err := tasksRepo.GetMembersTime(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.TaskMemberTime{{UserID: userID, TotalSeconds: 5400, BillableSeconds: 3600,
+		OwnSeconds: 3600, EntriesCount: 2}}, membersTime)
+}
+
+func TestTaskDates_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID, taskID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	require.NoError(t, db.GetContext(ctx, &taskID, `INSERT INTO task (name, project_id, start_at, due_at)
+VALUES ('Task', $1, '2024-07-10 00:00:00+00', '2024-07-20 00:00:00+00') RETURNING id`, projectID))
+
+	tasksRepo := NewTasksRepository(db)
+	// Single date is compared with the stored one
+	earlyDue := time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC)
+	_, err := tasksRepo.Update(ctx, &models.Task{ID: taskID, DueAt: &earlyDue})
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+
+	lateStart := time.Date(2024, 7, 25, 0, 0, 0, 0, time.UTC)
+	_, err = tasksRepo.Update(ctx, &models.Task{ID: taskID, StartAt: &lateStart})
+	require.NotNil(t, err)
+	assert.ErrorContains(t, httpErrors.ParseErrors(err), httpErrors.InvalidTimeRange.Error())
+
+	// Cleared due date lets the start move later
+	task, err := tasksRepo.Update(ctx, &models.Task{ID: taskID, StartAt: &lateStart, ClearDueAt: true})
+	require.NoError(t, err)
+	assert.Nil(t, task.DueAt)
+	assert.True(t, lateStart.Equal(*task.StartAt))
+}
+
+func TestTasksSearch_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	_, err := db.ExecContext(ctx, `INSERT INTO task (name, project_id)
+VALUES ('100% done', $1), ('1000 done', $1), ('snake_case', $1), ('snakecase', $1), ('back\slash', $1)`, projectID)
+	require.NoError(t, err)
+
+	tasksRepo := NewTasksRepository(db)
+	// Wildcards and escape characters of the search match literally
+	for search, name := range map[string]string{"0%": "100% done", "e_": "snake_case", `k\s`: `back\slash`} {
+		response, err := tasksRepo.Get(ctx, projectID, &utils.TasksQuery{Search: search})
+		require.NoError(t, err)
+		require.Len(t, response.Tasks, 1, search)
+		assert.Equal(t, name, response.Tasks[0].Name)
+	}
+}
+
+func TestTasksRepository_Pause(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var userID int64 = 9
+
+	mock.ExpectExec(pauseTaskQuery).WithArgs(task.ID, userID).WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.Nil(t, tasksRepo.Pause(context.Background(), task.ID, userID))
+
+	mock.ExpectExec(pauseTaskQuery).WithArgs(task.ID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NotNil(t, tasksRepo.Pause(context.Background(), task.ID, userID))
+}
+
+func TestTasksRepository_Resume(t *testing.T) {
+	tasksRepo, db, mock, err := newMockTasksRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	task := getTestTask()
+	var userID int64 = 9
+
+	mock.ExpectExec(resumeTaskQuery).WithArgs(task.ID, userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, tasksRepo.Resume(context.Background(), task.ID, userID))
+
+	mock.ExpectExec(resumeTaskQuery).WithArgs(task.ID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NotNil(t, tasksRepo.Resume(context.Background(), task.ID, userID))
 }
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+type timeEntriesRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewTimeEntriesRepository(db *sqlx.DB) projects.TimeEntriesRepository {
+	return timeEntriesRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (t timeEntriesRepository) Get(ctx context.Context, taskID int64, query *utils.TimeEntriesQuery) ([]*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Get")
+	defer span.End()
+
+	entries := make([]*models.TimeEntry, 0, 10)
+	return entries, t.stream(ctx, taskID, query, func(entry *models.TimeEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+}
+
+// Stream passes entries of Get to fn one by one as they are read from the database
+func (t timeEntriesRepository) Stream(ctx context.Context, taskID int64, query *utils.TimeEntriesQuery,
+	fn func(entry *models.TimeEntry) error) error {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Stream")
+	defer span.End()
+
+	return t.stream(ctx, taskID, query, fn)
+}
+
+func (t timeEntriesRepository) stream(ctx context.Context, taskID int64, query *utils.TimeEntriesQuery,
+	fn func(entry *models.TimeEntry) error) error {
+	rows, err := t.db.QueryxContext(ctx, selectTimeEntriesQuery, taskID, query.UserID, query.From, query.To,
+		query.TagID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry models.TimeEntry
+		if err = rows.StructScan(&entry); err != nil {
+			return err
+		}
+		if err = fn(&entry); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (t timeEntriesRepository) GetByID(ctx context.Context, taskID, entryID int64) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.GetByID")
+	defer span.End()
+
+	entry := &models.TimeEntry{}
+	if err := t.db.QueryRowxContext(ctx, getTimeEntryByIDQuery, entryID, taskID).StructScan(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (t timeEntriesRepository) Create(ctx context.Context, entry *models.TimeEntry) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Create")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tagIDs := entry.TagIDs
+	if err = tx.QueryRowxContext(ctx, createTimeEntryQuery, entry.TaskID, entry.UserID, entry.StartedAt,
+		entry.EndedAt, entry.Description, entry.Billable).StructScan(entry); err != nil {
+		return nil, err
+	}
+	if err = setTimeEntryTags(ctx, tx, entry.ID, tagIDs); err != nil {
+		return nil, err
+	}
+	entry.TagIDs = tagIDs
+	return entry, tx.Commit()
+}
+
+// Update changes the entry, its pauses are cut to the new range of the entry
+func (t timeEntriesRepository) Update(ctx context.Context, entry *models.TimeEntry) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Update")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tagIDs := entry.TagIDs
+	if err = tx.QueryRowxContext(ctx, updateTimeEntryQuery, entry.TaskID, entry.StartedAt, entry.EndedAt,
+		entry.Description, entry.Billable, entry.ID).StructScan(entry); err != nil {
+		return nil, err
+	}
+	// Paused time can't exceed the new range of the entry
+	if _, err = tx.ExecContext(ctx, deleteOuterTimeEntryPausesQuery, entry.ID, entry.StartedAt, entry.EndedAt); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, clipTimeEntryPausesQuery, entry.ID, entry.StartedAt, entry.EndedAt); err != nil {
+		return nil, err
+	}
+	// Tags of another project are dropped when the entry is moved, so they are set again
+	if err = setTimeEntryTags(ctx, tx, entry.ID, tagIDs); err != nil {
+		return nil, err
+	}
+	entry.TagIDs = tagIDs
+	return entry, tx.Commit()
+}
+
+func (t timeEntriesRepository) Delete(ctx context.Context, taskID, entryID int64) error {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Delete")
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, deleteTimeEntryQuery, entryID, taskID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (t timeEntriesRepository) IsProjectTask(ctx context.Context, projectID, taskID int64) error {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.IsProjectTask")
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, isProjectTaskQuery, projectID, taskID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// IsLocked checks whether the moment is closed by global or project lock
+func (t timeEntriesRepository) IsLocked(ctx context.Context, taskID int64, at time.Time) (bool, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.IsLocked")
+	defer span.End()
+
+	var locked bool
+	return locked, t.db.GetContext(ctx, &locked, isTimeEntryLockedQuery, taskID, at)
+}
+
+// IsApproved checks whether the week of the entry start is approved in the timesheet of the user
+func (t timeEntriesRepository) IsApproved(ctx context.Context, taskID, userID int64, startedAt time.Time) (bool, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.IsApproved")
+	defer span.End()
+
+	var approved bool
+	return approved, t.db.GetContext(ctx, &approved, isTimeEntryApprovedQuery, taskID, userID, startedAt)
+}
+
+func (t timeEntriesRepository) CountOverlapping(ctx context.Context, entry *models.TimeEntry) (int, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.CountOverlapping")
+	defer span.End()
+
+	var count int
+	return count, t.db.GetContext(ctx, &count, countOverlappingEntriesQuery, entry.UserID, entry.ID,
+		entry.StartedAt, entry.EndedAt)
+}
+
+func (t timeEntriesRepository) GetActive(ctx context.Context, userID int64) ([]*models.ActiveTimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.GetActive")
+	defer span.End()
+
+	rows, err := t.db.QueryxContext(ctx, getActiveTimeEntriesQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.ActiveTimeEntry, 0, 1)
+	for rows.Next() {
+		var entry models.ActiveTimeEntry
+		if err = rows.StructScan(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Switch stops all running entries of the user and starts the task in one transaction
+func (t timeEntriesRepository) Switch(ctx context.Context, taskID, userID int64) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.Switch")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, lockUserTimeEntriesQuery, userID); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, stopUserTimeEntriesQuery, userID); err != nil {
+		return nil, err
+	}
+
+	entry := &models.TimeEntry{}
+	if err = tx.QueryRowxContext(ctx, startTimeEntryQuery, taskID, userID).StructScan(entry); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, startTaskInProgressQuery, taskID); err != nil {
+		return nil, err
+	}
+	return entry, tx.Commit()
+}
+
+// AutoStop stops forgotten entries and returns amount of them
+func (t timeEntriesRepository) AutoStop(ctx context.Context, maxDuration time.Duration) (int64, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.AutoStop")
+	defer span.End()
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	stopped := make([]*models.TimeEntry, 0)
+	if err = tx.SelectContext(ctx, &stopped, autoStopTimeEntriesQuery, int64(maxDuration.Seconds())); err != nil {
+		return 0, err
+	}
+	// Entries are stopped in the past, so their pauses are cut as if the entries were edited
+	for _, entry := range stopped {
+		if _, err = tx.ExecContext(ctx, deleteOuterTimeEntryPausesQuery, entry.ID, entry.StartedAt,
+			entry.EndedAt); err != nil {
+			return 0, err
+		}
+		if _, err = tx.ExecContext(ctx, clipTimeEntryPausesQuery, entry.ID, entry.StartedAt, entry.EndedAt); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(stopped)), tx.Commit()
+}
+
+func (t timeEntriesRepository) GetSettings(ctx context.Context, userID int64) (*models.TimerSettings, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.GetSettings")
+	defer span.End()
+
+	settings := &models.TimerSettings{}
+	if err := t.db.QueryRowxContext(ctx, getTimerSettingsQuery, userID).StructScan(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (t timeEntriesRepository) UpdateSettings(ctx context.Context, settings *models.TimerSettings) (*models.TimerSettings, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesRepository.UpdateSettings")
+	defer span.End()
+
+	return settings, t.db.QueryRowxContext(ctx, upsertTimerSettingsQuery, settings.UserID, settings.WorkdayEnd,
+		settings.Timezone).StructScan(settings)
+}
+
+// setTimeEntryTags replaces tags of the entry. Every tag must belong to the project of the entry
+func setTimeEntryTags(ctx context.Context, tx *sqlx.Tx, entryID int64, tagIDs []int64) error {
+	if _, err := tx.ExecContext(ctx, deleteTimeEntryTagsQuery, entryID); err != nil {
+		return err
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	unique := make(map[int64]struct{}, len(tagIDs))
+	for _, tagID := range tagIDs {
+		unique[tagID] = struct{}{}
+	}
+	result, err := tx.ExecContext(ctx, addTimeEntryTagsQuery, entryID, pq.Array(tagIDs))
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != int64(len(unique)) {
+		return sql.ErrNoRows
+	}
+	return nil
+}
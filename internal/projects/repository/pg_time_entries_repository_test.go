@@ -0,0 +1,373 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log"
+	"testing"
+	"time"
+)
+
+func TestTimeEntriesRepository_Get(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	from := entry.StartedAt.Add(-time.Hour)
+	query := &utils.TimeEntriesQuery{From: &from, TagID: 3}
+	entry.TagIDs = []int64{3}
+
+	mock.ExpectQuery(selectTimeEntriesQuery).WithArgs(entry.TaskID, query.UserID, query.From, query.To, query.TagID).
+		WillReturnRows(sqlmock.NewRows(append(entry.Columns(), "tag_ids")).AddRow(append(entry.Fields(), "{3}")...))
+
+	gotEntries, err := entriesRepo.Get(context.Background(), entry.TaskID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TimeEntry{entry}, gotEntries)
+}
+
+func TestTimeEntriesRepository_Stream(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	query := &utils.TimeEntriesQuery{}
+
+	mock.ExpectQuery(selectTimeEntriesQuery).WithArgs(entry.TaskID, query.UserID, query.From, query.To, query.TagID).
+		WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...))
+
+	var gotEntries []*models.TimeEntry
+	err = entriesRepo.Stream(context.Background(), entry.TaskID, query, func(entry *models.TimeEntry) error {
+		gotEntries = append(gotEntries, entry)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.TimeEntry{entry}, gotEntries)
+}
+
+func TestTimeEntriesRepository_GetByID(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectQuery(getTimeEntryByIDQuery).WithArgs(entry.ID, entry.TaskID).
+		WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...))
+	gotEntry, err := entriesRepo.GetByID(context.Background(), entry.TaskID, entry.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, entry, gotEntry)
+
+	mock.ExpectQuery(getTimeEntryByIDQuery).WithArgs(entry.ID, entry.TaskID).WillReturnError(sql.ErrNoRows)
+	gotEntry, err = entriesRepo.GetByID(context.Background(), entry.TaskID, entry.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, gotEntry)
+}
+
+func TestTimeEntriesRepository_Create(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	description := "Code review"
+	entry.Description = &description
+	entry.TagIDs = []int64{3, 3}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(createTimeEntryQuery).WithArgs(entry.TaskID, entry.UserID, entry.StartedAt, entry.EndedAt,
+		entry.Description, entry.Billable).WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...))
+	mock.ExpectExec(deleteTimeEntryTagsQuery).WithArgs(entry.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(addTimeEntryTagsQuery).WithArgs(entry.ID, pq.Array(entry.TagIDs)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	gotEntry, err := entriesRepo.Create(context.Background(), entry)
+	assert.Nil(t, err)
+	assert.Equal(t, entry, gotEntry)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeEntriesRepository_Update(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateTimeEntryQuery).WithArgs(entry.TaskID, entry.StartedAt, entry.EndedAt, entry.Description,
+		entry.Billable, entry.ID).WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...))
+	mock.ExpectExec(deleteOuterTimeEntryPausesQuery).WithArgs(entry.ID, entry.StartedAt, entry.EndedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(clipTimeEntryPausesQuery).WithArgs(entry.ID, entry.StartedAt, entry.EndedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteTimeEntryTagsQuery).WithArgs(entry.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	gotEntry, err := entriesRepo.Update(context.Background(), entry)
+	assert.Nil(t, err)
+	assert.Equal(t, entry, gotEntry)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeEntriesRepository_Delete(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectExec(deleteTimeEntryQuery).WithArgs(entry.ID, entry.TaskID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, entriesRepo.Delete(context.Background(), entry.TaskID, entry.ID))
+
+	mock.ExpectExec(deleteTimeEntryQuery).WithArgs(entry.ID, entry.TaskID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.NotNil(t, entriesRepo.Delete(context.Background(), entry.TaskID, entry.ID))
+}
+
+func TestTimeEntriesRepository_IsProjectTask(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	var projectID, taskID int64 = 1, 2
+
+	mock.ExpectExec(isProjectTaskQuery).WithArgs(projectID, taskID).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, entriesRepo.IsProjectTask(context.Background(), projectID, taskID))
+
+	// Task of another project isn't found
+	mock.ExpectExec(isProjectTaskQuery).WithArgs(projectID+1, taskID).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorIs(t, entriesRepo.IsProjectTask(context.Background(), projectID+1, taskID), sql.ErrNoRows)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeEntriesRepository_CountOverlapping(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectQuery(countOverlappingEntriesQuery).
+		WithArgs(entry.UserID, entry.ID, entry.StartedAt, entry.EndedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := entriesRepo.CountOverlapping(context.Background(), entry)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestTimeEntriesRepository_IsApproved(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectQuery(isTimeEntryApprovedQuery).WithArgs(entry.TaskID, entry.UserID, entry.StartedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	approved, err := entriesRepo.IsApproved(context.Background(), entry.TaskID, entry.UserID, entry.StartedAt)
+	assert.Nil(t, err)
+	assert.True(t, approved)
+}
+
+func TestTimeEntriesRepository_IsLocked(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	mock.ExpectQuery(isTimeEntryLockedQuery).WithArgs(entry.TaskID, entry.StartedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	locked, err := entriesRepo.IsLocked(context.Background(), entry.TaskID, entry.StartedAt)
+	assert.Nil(t, err)
+	assert.False(t, locked)
+}
+
+func TestTimeEntriesRepository_GetActive(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	entry.EndedAt = nil
+	active := &models.ActiveTimeEntry{
+		TimeEntry:   *entry,
+		TaskName:    "Lorem",
+		ProjectID:   4,
+		ProjectName: "Some project",
+		Paused:      true,
+	}
+
+	mock.ExpectQuery(getActiveTimeEntriesQuery).WithArgs(entry.UserID).WillReturnRows(
+		sqlmock.NewRows(append(entry.Columns(), "task_name", "project_id", "project_name", "paused")).
+			AddRow(append(entry.Fields(), active.TaskName, active.ProjectID, active.ProjectName, active.Paused)...),
+	)
+
+	gotEntries, err := entriesRepo.GetActive(context.Background(), entry.UserID)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.ActiveTimeEntry{active}, gotEntries)
+}
+
+func TestTimeEntriesRepository_Switch(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+	entry.EndedAt = nil
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockUserTimeEntriesQuery).WithArgs(entry.UserID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(stopUserTimeEntriesQuery).WithArgs(entry.UserID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(startTimeEntryQuery).WithArgs(entry.TaskID, entry.UserID).
+		WillReturnRows(sqlmock.NewRows(entry.Columns()).AddRow(entry.Fields()...))
+	mock.ExpectExec(startTaskInProgressQuery).WithArgs(entry.TaskID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	gotEntry, err := entriesRepo.Switch(context.Background(), entry.TaskID, entry.UserID)
+	assert.Nil(t, err)
+	assert.Equal(t, entry, gotEntry)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockUserTimeEntriesQuery).WithArgs(entry.UserID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(stopUserTimeEntriesQuery).WithArgs(entry.UserID).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(startTimeEntryQuery).WithArgs(entry.TaskID, entry.UserID).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	gotEntry, err = entriesRepo.Switch(context.Background(), entry.TaskID, entry.UserID)
+	assert.NotNil(t, err)
+	assert.Nil(t, gotEntry)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeEntriesRepository_AutoStop(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry := getTestTimeEntry()
+
+	// Pauses of the stopped entries are cut to their new range
+	mock.ExpectBegin()
+	mock.ExpectQuery(autoStopTimeEntriesQuery).WithArgs(int64(12 * 60 * 60)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "started_at", "ended_at"}).
+			AddRow(entry.ID, entry.StartedAt, *entry.EndedAt))
+	mock.ExpectExec(deleteOuterTimeEntryPausesQuery).WithArgs(entry.ID, entry.StartedAt, entry.EndedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(clipTimeEntryPausesQuery).WithArgs(entry.ID, entry.StartedAt, entry.EndedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	stopped, err := entriesRepo.AutoStop(context.Background(), 12*time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), stopped)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeEntriesRepository_UpdateSettings(t *testing.T) {
+	entriesRepo, db, mock, err := newMockTimeEntriesRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	workdayEnd := "18:30"
+	settings := &models.TimerSettings{UserID: 10, WorkdayEnd: &workdayEnd, Timezone: "Europe/Moscow"}
+
+	mock.ExpectQuery(upsertTimerSettingsQuery).WithArgs(settings.UserID, settings.WorkdayEnd, settings.Timezone).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "workday_end", "timezone"}).
+			AddRow(settings.UserID, workdayEnd, settings.Timezone))
+
+	gotSettings, err := entriesRepo.UpdateSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.Equal(t, settings, gotSettings)
+
+	mock.ExpectQuery(getTimerSettingsQuery).WithArgs(settings.UserID).WillReturnError(sql.ErrNoRows)
+	gotSettings, err = entriesRepo.GetSettings(context.Background(), settings.UserID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, gotSettings)
+}
+
+func TestEntryProjectTask_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var ownerID, projectAID, projectBID, taskBID int64
+	require.NoError(t, db.GetContext(ctx, &ownerID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('owner@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectAID, `INSERT INTO project (name, creator_id)
+VALUES ('Project A', $1) RETURNING id`, ownerID))
+	require.NoError(t, db.GetContext(ctx, &projectBID, `INSERT INTO project (name, creator_id)
+VALUES ('Project B', $1) RETURNING id`, ownerID))
+	require.NoError(t, db.GetContext(ctx, &taskBID, `INSERT INTO task (name, project_id)
+VALUES ('Task B', $1) RETURNING id`, projectBID))
+
+	// Entries of the task of project B aren't reachable through project A
+	entriesRepo := NewTimeEntriesRepository(db)
+	assert.Nil(t, entriesRepo.IsProjectTask(ctx, projectBID, taskBID))
+	assert.ErrorIs(t, entriesRepo.IsProjectTask(ctx, projectAID, taskBID), sql.ErrNoRows)
+}
+
+func TestAutoStopPauses_Postgres(t *testing.T) {
+	ctx := context.Background()
+
+	postgresC, db := SetupPostgres(ctx)
+	defer func() {
+		if err := postgresC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer db.Close()
+
+	var userID, projectID, taskID, entryID int64
+	require.NoError(t, db.GetContext(ctx, &userID, `INSERT INTO "user" (email, password, name, surname)
+VALUES ('user@example.com', 'password', 'Name', 'Surname') RETURNING id`))
+	require.NoError(t, db.GetContext(ctx, &projectID, `INSERT INTO project (name, creator_id)
+VALUES ('Project', $1) RETURNING id`, userID))
+	require.NoError(t, db.GetContext(ctx, &taskID, `INSERT INTO task (name, project_id)
+VALUES ('Task', $1) RETURNING id`, projectID))
+	startedAt := time.Now().UTC().Add(-4 * time.Hour).Truncate(time.Second)
+	require.NoError(t, db.GetContext(ctx, &entryID, `INSERT INTO time_entry (task_id, user_id, started_at)
+VALUES ($1, $2, $3) RETURNING id`, taskID, userID, startedAt))
+	// The first pause crosses the one hour cap, the second one is after it
+	_, err := db.ExecContext(ctx, `INSERT INTO time_entry_pause (time_entry_id, started_at, ended_at)
+VALUES ($1, $2, $3), ($1, $4, $5)`, entryID, startedAt.Add(30*time.Minute), startedAt.Add(2*time.Hour),
+		startedAt.Add(150*time.Minute), startedAt.Add(160*time.Minute))
+	require.NoError(t, err)
+
+	stopped, err := NewTimeEntriesRepository(db).AutoStop(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stopped)
+
+	var pauses []struct {
+		StartedAt time.Time `db:"started_at"`
+		EndedAt   time.Time `db:"ended_at"`
+	}
+	require.NoError(t, db.SelectContext(ctx, &pauses, `SELECT started_at, ended_at FROM time_entry_pause
+WHERE time_entry_id = $1`, entryID))
+	require.Len(t, pauses, 1)
+	assert.True(t, startedAt.Add(30*time.Minute).Equal(pauses[0].StartedAt))
+	assert.True(t, startedAt.Add(time.Hour).Equal(pauses[0].EndedAt))
+
+	// Net time of the entry is the hour without the paused half
+	membersTime, err := NewTasksRepository(db).GetMembersTime(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, membersTime, 1)
+	assert.Equal(t, int64(1800), membersTime[0].TotalSeconds)
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+type timesheetsRepository struct {
+	db     *sqlx.DB
+	tracer trace.Tracer
+}
+
+func NewTimesheetsRepository(db *sqlx.DB) projects.TimesheetsRepository {
+	return timesheetsRepository{db: db, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (t timesheetsRepository) Get(ctx context.Context, projectID int64, query *utils.TimesheetsQuery) ([]*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.Get")
+	defer span.End()
+
+	return t.selectTimesheets(ctx, selectProjectTimesheetsQuery, projectID, query.UserID, query.Status)
+}
+
+func (t timesheetsRepository) GetPending(ctx context.Context, approverID int64, all bool) ([]*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.GetPending")
+	defer span.End()
+
+	return t.selectTimesheets(ctx, selectPendingTimesheetsQuery, all, approverID)
+}
+
+func (t timesheetsRepository) GetByID(ctx context.Context, projectID, timesheetID int64) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.GetByID")
+	defer span.End()
+
+	var timesheet models.Timesheet
+	if err := t.db.GetContext(ctx, &timesheet, getTimesheetByIDQuery, timesheetID, projectID); err != nil {
+		return nil, err
+	}
+	return &timesheet, nil
+}
+
+// HasRunningEntries checks whether the member has running entries in the project during the week
+func (t timesheetsRepository) HasRunningEntries(ctx context.Context, projectID, userID int64, weekStart time.Time) (bool, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.HasRunningEntries")
+	defer span.End()
+
+	var running bool
+	if err := t.db.GetContext(ctx, &running, hasRunningTimesheetEntriesQuery, projectID, userID, weekStart); err != nil {
+		return false, err
+	}
+	return running, nil
+}
+
+// Submit creates the timesheet or resubmits the rejected one
+func (t timesheetsRepository) Submit(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.Submit")
+	defer span.End()
+
+	err := t.db.GetContext(ctx, &timesheet.ID, submitTimesheetQuery, timesheet.ProjectID, timesheet.UserID,
+		timesheet.WeekStart)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, httpErrors.SubmittedTimesheet
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t.GetByID(ctx, timesheet.ProjectID, timesheet.ID)
+}
+
+// Review approves or rejects the submitted timesheet
+func (t timesheetsRepository) Review(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsRepository.Review")
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, reviewTimesheetQuery, timesheet.Status, timesheet.Comment,
+		timesheet.ReviewedBy, timesheet.ID, timesheet.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return t.GetByID(ctx, timesheet.ProjectID, timesheet.ID)
+}
+
+func (t timesheetsRepository) selectTimesheets(ctx context.Context, query string, args ...interface{}) ([]*models.Timesheet, error) {
+	rows, err := t.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	timesheets := make([]*models.Timesheet, 0, 10)
+	for rows.Next() {
+		var timesheet models.Timesheet
+		if err = rows.StructScan(&timesheet); err != nil {
+			return nil, err
+		}
+		timesheets = append(timesheets, &timesheet)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return timesheets, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTimesheetsRepository_Get(t *testing.T) {
+	timesheetsRepo, db, mock, err := newMockTimesheetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timesheet := getTestTimesheet()
+	query := &utils.TimesheetsQuery{Status: models.TimesheetStatusSubmitted}
+
+	mock.ExpectQuery(selectProjectTimesheetsQuery).WithArgs(timesheet.ProjectID, query.UserID, query.Status).
+		WillReturnRows(sqlmock.NewRows(timesheet.Columns()).AddRow(timesheet.Fields()...))
+
+	gotTimesheets, err := timesheetsRepo.Get(context.Background(), timesheet.ProjectID, query)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Timesheet{timesheet}, gotTimesheets)
+}
+
+func TestTimesheetsRepository_GetPending(t *testing.T) {
+	timesheetsRepo, db, mock, err := newMockTimesheetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timesheet := getTestTimesheet()
+	var approverID int64 = 2
+
+	mock.ExpectQuery(selectPendingTimesheetsQuery).WithArgs(false, approverID).
+		WillReturnRows(sqlmock.NewRows(timesheet.Columns()).AddRow(timesheet.Fields()...))
+
+	gotTimesheets, err := timesheetsRepo.GetPending(context.Background(), approverID, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []*models.Timesheet{timesheet}, gotTimesheets)
+}
+
+func TestTimesheetsRepository_Submit(t *testing.T) {
+	timesheetsRepo, db, mock, err := newMockTimesheetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timesheet := getTestTimesheet()
+	request := &models.Timesheet{ProjectID: timesheet.ProjectID, UserID: timesheet.UserID, WeekStart: timesheet.WeekStart}
+
+	mock.ExpectQuery(submitTimesheetQuery).WithArgs(request.ProjectID, request.UserID, request.WeekStart).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(timesheet.ID))
+	mock.ExpectQuery(getTimesheetByIDQuery).WithArgs(timesheet.ID, timesheet.ProjectID).
+		WillReturnRows(sqlmock.NewRows(timesheet.Columns()).AddRow(timesheet.Fields()...))
+
+	gotTimesheet, err := timesheetsRepo.Submit(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, timesheet, gotTimesheet)
+
+	// Submitted and approved weeks aren't updated by upsert
+	mock.ExpectQuery(submitTimesheetQuery).WithArgs(request.ProjectID, request.UserID, request.WeekStart).
+		WillReturnError(sql.ErrNoRows)
+
+	gotTimesheet, err = timesheetsRepo.Submit(context.Background(), request)
+	assert.ErrorIs(t, err, httpErrors.SubmittedTimesheet)
+	assert.Nil(t, gotTimesheet)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimesheetsRepository_HasRunningEntries(t *testing.T) {
+	timesheetsRepo, db, mock, err := newMockTimesheetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timesheet := getTestTimesheet()
+
+	mock.ExpectQuery(hasRunningTimesheetEntriesQuery).WithArgs(timesheet.ProjectID, timesheet.UserID, timesheet.WeekStart).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	running, err := timesheetsRepo.HasRunningEntries(context.Background(), timesheet.ProjectID, timesheet.UserID,
+		timesheet.WeekStart)
+	assert.Nil(t, err)
+	assert.True(t, running)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestTimesheetsRepository_Review(t *testing.T) {
+	timesheetsRepo, db, mock, err := newMockTimesheetsRepo()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timesheet := getTestTimesheet()
+	var reviewerID int64 = 2
+	reviewedAt := time.Date(2024, 7, 9, 12, 0, 0, 0, time.UTC)
+	timesheet.Status = models.TimesheetStatusApproved
+	timesheet.ReviewedBy = &reviewerID
+
+	mock.ExpectExec(reviewTimesheetQuery).WithArgs(timesheet.Status, timesheet.Comment, timesheet.ReviewedBy,
+		timesheet.ID, timesheet.ProjectID).WillReturnResult(sqlmock.NewResult(0, 1))
+	reviewed := *timesheet
+	reviewed.ReviewedAt = &reviewedAt
+	mock.ExpectQuery(getTimesheetByIDQuery).WithArgs(timesheet.ID, timesheet.ProjectID).
+		WillReturnRows(sqlmock.NewRows(reviewed.Columns()).AddRow(reviewed.Fields()...))
+
+	gotTimesheet, err := timesheetsRepo.Review(context.Background(), timesheet)
+	assert.Nil(t, err)
+	assert.Equal(t, &reviewed, gotTimesheet)
+
+	mock.ExpectExec(reviewTimesheetQuery).WithArgs(timesheet.Status, timesheet.Comment, timesheet.ReviewedBy,
+		timesheet.ID, timesheet.ProjectID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	gotTimesheet, err = timesheetsRepo.Review(context.Background(), timesheet)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, gotTimesheet)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"strconv"
+	"time"
+)
+
+type tasksRedisRepo struct {
+	rdb    *redis.Client
+	tracer trace.Tracer
+}
+
+func NewTasksRedisRepo(rdb *redis.Client) projects.TasksRedisRepository {
+	return tasksRedisRepo{rdb: rdb, tracer: otel.GetTracerProvider().Tracer("api")}
+}
+
+func (c tasksRedisRepo) SetTask(ctx context.Context, task *models.TaskDetail, seconds int64) error {
+	ctx, span := c.tracer.Start(ctx, "tasksRedisRepo.SetTask")
+	defer span.End()
+
+	jsonTask, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, "task:"+strconv.FormatInt(task.ID, 10), string(jsonTask), time.Duration(seconds)*time.Second).Err()
+}
+
+func (c tasksRedisRepo) GetTask(ctx context.Context, taskID int64) (*models.TaskDetail, error) {
+	ctx, span := c.tracer.Start(ctx, "tasksRedisRepo.GetTask")
+	defer span.End()
+
+	cmd := c.rdb.Get(ctx, "task:"+strconv.FormatInt(taskID, 10))
+	if cmd.Err() != nil {
+		return nil, cmd.Err()
+	}
+	var task models.TaskDetail
+	if err := json.Unmarshal([]byte(cmd.Val()), &task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (c tasksRedisRepo) DeleteTask(ctx context.Context, taskID int64) error {
+	ctx, span := c.tracer.Start(ctx, "tasksRedisRepo.DeleteTask")
+	defer span.End()
+
+	return c.rdb.Del(ctx, "task:"+strconv.FormatInt(taskID, 10)).Err()
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"testing"
+	"time"
+)
+
+func getTestTaskDetail() *models.TaskDetail {
+	return &models.TaskDetail{
+		Task:            *getTestTask(),
+		Members:         []*models.User{},
+		TotalSeconds:    5400,
+		BillableSeconds: 3600,
+		MembersTime: []*models.TaskMemberTime{
+			{UserID: 10, TotalSeconds: 5400, BillableSeconds: 3600, EntriesCount: 2},
+		},
+		RunningEntries: []*models.ActiveTimeEntry{},
+		CalculatedAt:   time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestTasksRedisRepo_GetTask(t *testing.T) {
+	ctx := context.Background()
+
+	redisC, rdb := SetupRedis(ctx)
+	defer func() {
+		if err := redisC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer rdb.Close()
+
+	repo := NewTasksRedisRepo(rdb)
+	task := getTestTaskDetail()
+
+	gotTask, err := repo.GetTask(ctx, task.ID)
+	assert.Nil(t, gotTask)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, repo.SetTask(ctx, task, 10))
+	gotTask, err = repo.GetTask(ctx, task.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, task, gotTask)
+}
+
+func TestTasksRedisRepo_DeleteTask(t *testing.T) {
+	ctx := context.Background()
+
+	redisC, rdb := SetupRedis(ctx)
+	defer func() {
+		if err := redisC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer rdb.Close()
+
+	repo := NewTasksRedisRepo(rdb)
+	task := getTestTaskDetail()
+
+	assert.Nil(t, repo.SetTask(ctx, task, 10))
+	assert.Nil(t, repo.DeleteTask(ctx, task.ID))
+	gotTask, err := repo.GetTask(ctx, task.ID)
+	assert.NotNil(t, err)
+	assert.Nil(t, gotTask)
+}
@@ -0,0 +1,11 @@
+package repository
+
+const (
+	selectTaskAttachmentsQuery = `SELECT * FROM task_attachment WHERE task_id = $1 ORDER BY id`
+	getTaskAttachmentQuery     = `SELECT * FROM task_attachment WHERE id = $1 AND task_id = $2`
+	createTaskAttachmentQuery  = `INSERT INTO task_attachment (task_id, uploader_id, name, content_type, size, storage_key)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	deleteTaskAttachmentQuery = `DELETE FROM task_attachment WHERE id = $1 AND task_id = $2`
+	selectStorageOrphansQuery = `SELECT storage_key FROM storage_orphan ORDER BY deleted_at LIMIT $1`
+	deleteStorageOrphansQuery = `DELETE FROM storage_orphan WHERE storage_key = ANY($1)`
+)
@@ -0,0 +1,36 @@
+package repository
+
+const (
+	projectBudgetColumns = `project_id, kind, amount, period, thresholds, block_start, updated_by, updated_at`
+	getBudgetQuery       = `SELECT ` + projectBudgetColumns + ` FROM project_budget WHERE project_id = $1`
+	getTaskBudgetQuery   = `SELECT ` + projectBudgetColumns + ` FROM project_budget
+WHERE project_id = (SELECT project_id FROM task WHERE id = $1)`
+	setBudgetQuery = `INSERT INTO project_budget (project_id, kind, amount, period, thresholds, block_start, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (project_id) DO UPDATE SET
+kind = excluded.kind,
+amount = excluded.amount,
+period = excluded.period,
+thresholds = excluded.thresholds,
+block_start = excluded.block_start,
+updated_by = excluded.updated_by,
+updated_at = now()
+RETURNING ` + projectBudgetColumns
+	deleteBudgetQuery = `DELETE FROM project_budget WHERE project_id = $1`
+	// Thresholds are notified again after the budget is changed
+	deleteBudgetAlertsQuery = `DELETE FROM budget_alert WHERE project_id = $1`
+	// getBudgetConsumptionQuery sums worked seconds and billable amount of the project entries started since $2,
+	// all entries are summed when $2 is null
+	getBudgetConsumptionQuery = `SELECT COALESCE(SUM(seconds), 0)::bigint AS tracked_seconds,
+ROUND(COALESCE(SUM(seconds / 3600 * rate) FILTER (WHERE billable), 0), 2) AS amount
+FROM (SELECT time_entry.billable,
+` + timeEntrySecondsRateColumns + `
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id` + timeEntrySecondsRateJoins + `WHERE task.project_id = $1
+  AND ($2::timestamptz IS NULL OR time_entry.started_at >= $2)) entry`
+	// createBudgetAlertsQuery returns only thresholds which were not crossed before in the period
+	createBudgetAlertsQuery = `INSERT INTO budget_alert (project_id, period_start, threshold)
+SELECT $1, $2::date, unnest($3::integer[])
+ON CONFLICT (project_id, (coalesce(period_start, '-infinity'::date)), threshold) DO NOTHING
+RETURNING threshold`
+)
@@ -0,0 +1,15 @@
+package repository
+
+const (
+	selectTaskCommentsQuery = `SELECT * FROM task_comment
+WHERE task_id = $1 AND id > $2
+ORDER BY id
+LIMIT $3`
+	getTaskCommentQuery    = `SELECT * FROM task_comment WHERE id = $1 AND task_id = $2`
+	createTaskCommentQuery = `INSERT INTO task_comment (task_id, author_id, body, mentions)
+VALUES ($1, $2, $3, $4) RETURNING *`
+	updateTaskCommentQuery = `UPDATE task_comment SET body = $1, mentions = $2, edited_at = now()
+WHERE id = $3 AND task_id = $4
+RETURNING *`
+	deleteTaskCommentQuery = `DELETE FROM task_comment WHERE id = $1 AND task_id = $2`
+)
@@ -0,0 +1,33 @@
+package repository
+
+const (
+	dependencyTaskColumns   = `task.id, task.name, task.status_id, task_status.category = 'done' AS done`
+	selectTaskBlockersQuery = `SELECT ` + dependencyTaskColumns + `
+FROM task_dependency
+INNER JOIN task ON task.id = task_dependency.blocker_id
+INNER JOIN task_status ON task_status.id = task.status_id
+WHERE task_dependency.task_id = $1
+ORDER BY task.id`
+	// Dependencies of the project are changed one at a time, so concurrent changes can't make a cycle
+	lockProjectDependenciesQuery = `SELECT FROM project WHERE id = $1 FOR UPDATE`
+	// isTaskBlockedByQuery tells whether the task $1 is blocked by the task $2 directly or through other blockers
+	isTaskBlockedByQuery = `WITH RECURSIVE blocker AS (SELECT blocker_id FROM task_dependency WHERE task_id = $1
+    UNION
+    SELECT task_dependency.blocker_id FROM task_dependency
+    INNER JOIN blocker ON task_dependency.task_id = blocker.blocker_id)
+SELECT EXISTS(SELECT FROM blocker WHERE blocker_id = $2)`
+	createTaskDependencyQuery  = `INSERT INTO task_dependency (task_id, blocker_id) VALUES ($1, $2)`
+	deleteTaskDependencyQuery  = `DELETE FROM task_dependency WHERE task_id = $1 AND blocker_id = $2`
+	selectDependencyTasksQuery = `SELECT ` + dependencyTaskColumns + `
+FROM task
+INNER JOIN task_status ON task_status.id = task.status_id
+WHERE task.project_id = $1
+  AND (EXISTS(SELECT FROM task_dependency WHERE task_id = task.id)
+    OR EXISTS(SELECT FROM task_dependency WHERE blocker_id = task.id))
+ORDER BY task.id`
+	selectProjectDependenciesQuery = `SELECT task_dependency.*
+FROM task_dependency
+INNER JOIN task ON task.id = task_dependency.task_id
+WHERE task.project_id = $1
+ORDER BY task_dependency.task_id, task_dependency.blocker_id`
+)
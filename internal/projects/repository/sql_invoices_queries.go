@@ -0,0 +1,58 @@
+package repository
+
+const (
+	selectInvoicesQuery     = `SELECT * FROM invoice WHERE project_id = $1 ORDER BY number DESC`
+	getInvoiceByIDQuery     = `SELECT * FROM invoice WHERE id = $1 AND project_id = $2`
+	selectInvoiceLinesQuery = `SELECT * FROM invoice_line WHERE invoice_id = $1 ORDER BY id`
+
+	// Invoices are numbered without gaps, so concurrent creation is serialized
+	lockInvoicesQuery  = `LOCK TABLE invoice IN EXCLUSIVE MODE`
+	createInvoiceQuery = `INSERT INTO invoice (number, project_id, created_by, period_from, period_to, group_by, tax_rate)
+SELECT COALESCE(MAX(number), 0) + 1, $1, $2, $3, $4, $5, $6 FROM invoice
+RETURNING id`
+	// Running entries aren't invoiced, period_to is inclusive
+	invoiceTimeEntriesQuery = `UPDATE time_entry SET invoice_id = $1
+FROM task
+WHERE task.id = time_entry.task_id
+  AND task.project_id = $2
+  AND time_entry.billable
+  AND time_entry.invoice_id IS NULL
+  AND time_entry.ended_at IS NOT NULL
+  AND time_entry.started_at >= $3::date
+  AND time_entry.started_at < $4::date + 1`
+	invoicedTimeEntriesQuery = `SELECT time_entry.task_id, time_entry.user_id,
+` + timeEntrySecondsRateColumns + `
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id` + timeEntrySecondsRateJoins + `WHERE time_entry.invoice_id = $1`
+	createInvoiceLinesByTaskQuery = `WITH entry AS (` + invoicedTimeEntriesQuery + `)
+INSERT INTO invoice_line (invoice_id, task_id, description, seconds, amount)
+SELECT $1, entry.task_id, task.name, ROUND(SUM(entry.seconds)), ROUND(SUM(entry.seconds / 3600 * entry.rate), 2)
+FROM entry
+INNER JOIN task ON task.id = entry.task_id
+GROUP BY entry.task_id, task.name
+ORDER BY task.name`
+	createInvoiceLinesByMemberQuery = `WITH entry AS (` + invoicedTimeEntriesQuery + `)
+INSERT INTO invoice_line (invoice_id, user_id, description, seconds, amount)
+SELECT $1, entry.user_id, "user".name || ' ' || "user".surname, ROUND(SUM(entry.seconds)),
+ROUND(SUM(entry.seconds / 3600 * entry.rate), 2)
+FROM entry
+INNER JOIN "user" ON "user".id = entry.user_id
+GROUP BY entry.user_id, "user".name, "user".surname
+ORDER BY "user".name, "user".surname`
+	updateInvoiceTotalsQuery = `UPDATE invoice SET
+subtotal = lines.amount,
+tax = ROUND(lines.amount * invoice.tax_rate / 100, 2),
+total = lines.amount + ROUND(lines.amount * invoice.tax_rate / 100, 2)
+FROM (SELECT COALESCE(SUM(amount), 0) AS amount FROM invoice_line WHERE invoice_id = $1) lines
+WHERE invoice.id = $1
+RETURNING invoice.*`
+
+	updateInvoiceStatusQuery = `UPDATE invoice SET
+status = $1,
+sent_at = CASE WHEN $1 = 'sent' THEN now() ELSE sent_at END,
+paid_at = CASE WHEN $1 = 'paid' THEN now() ELSE paid_at END
+WHERE id = $2 AND project_id = $3 AND status = $4
+RETURNING *`
+	// Entries of the deleted invoice are released by foreign key
+	deleteInvoiceQuery = `DELETE FROM invoice WHERE id = $1 AND project_id = $2 AND status = 'draft'`
+)
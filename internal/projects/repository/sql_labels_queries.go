@@ -0,0 +1,16 @@
+package repository
+
+const (
+	selectLabelsQuery = `SELECT * FROM label WHERE project_id = $1 ORDER BY name`
+	createLabelQuery  = `INSERT INTO label (project_id, name, color) VALUES ($1, $2, $3) RETURNING *`
+	updateLabelQuery  = `UPDATE label SET name = $1, color = COALESCE(NULLIF($2, ''), color)
+WHERE id = $3 AND project_id = $4
+RETURNING *`
+	deleteLabelQuery = `DELETE FROM label WHERE id = $1 AND project_id = $2`
+	// attachLabelQuery attaches the label $2 to the task $1 if they are of the same project
+	attachLabelQuery = `INSERT INTO task_label (task_id, label_id)
+SELECT task.id, label.id FROM task
+INNER JOIN label ON label.project_id = task.project_id
+WHERE task.id = $1 AND label.id = $2`
+	detachLabelQuery = `DELETE FROM task_label WHERE task_id = $1 AND label_id = $2`
+)
@@ -0,0 +1,19 @@
+package repository
+
+const (
+	periodLockColumns = `id, project_id, to_char(locked_until, 'YYYY-MM-DD') AS locked_until, updated_by, updated_at`
+	selectLocksQuery  = `SELECT ` + periodLockColumns + `
+FROM period_lock
+ORDER BY project_id NULLS FIRST`
+	// Global lock is selected and deleted with zero projectID
+	getLockQuery = `SELECT ` + periodLockColumns + `
+FROM period_lock
+WHERE project_id IS NOT DISTINCT FROM NULLIF($1::bigint, 0)`
+	setLockQuery = `INSERT INTO period_lock (project_id, locked_until, updated_by) VALUES ($1, $2, $3)
+ON CONFLICT ((coalesce(project_id, 0))) DO UPDATE SET
+locked_until = excluded.locked_until,
+updated_by = excluded.updated_by,
+updated_at = now()
+RETURNING ` + periodLockColumns
+	deleteLockQuery = `DELETE FROM period_lock WHERE project_id IS NOT DISTINCT FROM NULLIF($1::bigint, 0)`
+)
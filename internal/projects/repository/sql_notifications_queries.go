@@ -0,0 +1,15 @@
+package repository
+
+const (
+	selectNotificationsQuery = `SELECT * FROM notification
+WHERE user_id = $1
+  AND (NOT $2::bool OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT $3`
+	createNotificationQuery = `INSERT INTO notification (user_id, type, project_id, task_id, message, payload)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	readNotificationQuery = `UPDATE notification SET read_at = COALESCE(read_at, now())
+WHERE id = $1 AND user_id = $2
+RETURNING *`
+	readAllNotificationsQuery = `UPDATE notification SET read_at = now() WHERE user_id = $1 AND read_at IS NULL`
+)
@@ -2,15 +2,18 @@ package repository
 
 const (
 	getProjectByIDQuery = `SELECT * FROM project WHERE id = $1`
-	createProjectQuery  = `INSERT INTO project (name, description, creator_id) VALUES
-	 ($1, $2, $3) RETURNING *`
+	createProjectQuery  = `INSERT INTO project (name, description, creator_id, billable, start_in_progress, warn_blocked_start)
+VALUES ($1, $2, $3, COALESCE($4, false), COALESCE($5, false), COALESCE($6, false)) RETURNING *`
 	deleteProjectQuery = `DELETE FROM project WHERE id = $1`
 
 	updateProjectQuery = `UPDATE project SET
 name = COALESCE(NULLIF($1, ''), name),
 description = COALESCE(NULLIF($2, ''), description),
-creator_id = COALESCE(NULLIF($3, 0), creator_id)
-WHERE id = $4
+creator_id = COALESCE(NULLIF($3, 0), creator_id),
+billable = COALESCE($4, billable),
+start_in_progress = COALESCE($5, start_in_progress),
+warn_blocked_start = COALESCE($6, warn_blocked_start)
+WHERE id = $7
 RETURNING *`
 
 	isProjectMemberQuery = `SELECT FROM project_participant WHERE project_id = $1 AND user_id = $2`
@@ -20,13 +23,56 @@ RETURNING *`
 	getProjectMembers      = `SELECT "user".* FROM "user" 
 INNER JOIN project_participant ON "user".id = project_participant.user_id
 WHERE project_id = $1`
-	addProjectMemberQuery             = `INSERT INTO project_participant (project_id, user_id) VALUES ($1, $2)`
-	removeProjectMemberQuery          = `DELETE FROM project_participant  WHERE project_id = $1 AND user_id = $2`
-	getProjectMemberProductivityQuery = `SELECT task_id, SUM(EXTRACT(EPOCH FROM (time_entry.ended_at - started_at))) 
-AS total_seconds FROM time_entry
-INNER JOIN task ON task.id = time_entry.task_id
-WHERE task.project_id = $1
+	addProjectMemberQuery    = `INSERT INTO project_participant (project_id, user_id) VALUES ($1, $2)`
+	removeProjectMemberQuery = `DELETE FROM project_participant  WHERE project_id = $1 AND user_id = $2`
+	// timeEntryPauseJoin joins paused seconds of the entry
+	timeEntryPauseJoin = `
+LEFT JOIN LATERAL (SELECT SUM(EXTRACT(EPOCH FROM (COALESCE(time_entry_pause.ended_at, now()) - time_entry_pause.started_at)))
+    AS seconds
+    FROM time_entry_pause WHERE time_entry_pause.time_entry_id = time_entry.id) pause ON true
+`
+	// timeEntrySecondsRateJoins join paused seconds of the entry and hourly rate effective at the start of the entry.
+	// Rate of the project member overrides rate of the project, which overrides workspace rate of the user
+	timeEntrySecondsRateJoins = timeEntryPauseJoin + `LEFT JOIN LATERAL (SELECT hourly_rate.rate FROM hourly_rate
+    WHERE (hourly_rate.user_id = time_entry.user_id OR hourly_rate.user_id IS NULL)
+      AND (hourly_rate.project_id = task.project_id OR hourly_rate.project_id IS NULL)
+      AND hourly_rate.effective_from <= time_entry.started_at::date
+    ORDER BY hourly_rate.project_id IS NULL, hourly_rate.user_id IS NULL, hourly_rate.effective_from DESC
+    LIMIT 1) rate ON true
+`
+	timeEntrySecondsRateColumns = `EXTRACT(EPOCH FROM (COALESCE(time_entry.ended_at, now()) - time_entry.started_at)) - COALESCE(pause.seconds, 0)
+AS seconds,
+COALESCE(rate.rate, 0) AS rate`
+	// approvedTimesheetExists checks whether the week of the entry is approved
+	approvedTimesheetExists = `EXISTS(SELECT FROM timesheet
+    WHERE timesheet.project_id = task.project_id
+      AND timesheet.user_id = time_entry.user_id
+      AND timesheet.week_start = date_trunc('week', time_entry.started_at)::date
+      AND timesheet.status = 'approved')`
+	// memberTimeEntriesQuery returns worked seconds of the member entries without pauses and hourly rate
+	memberTimeEntriesQuery = `SELECT time_entry.id, time_entry.task_id, time_entry.billable,
+` + timeEntrySecondsRateColumns + `
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id` + timeEntrySecondsRateJoins + `WHERE task.project_id = $1
   AND time_entry.user_id = $2
+  AND ($3::bigint = 0 OR EXISTS(SELECT FROM time_entry_tag WHERE time_entry_id = time_entry.id AND tag_id = $3))
+  AND (NOT $4::bool OR ` + approvedTimesheetExists + `)`
+	getProjectMemberProductivityQuery = `WITH entry AS (` + memberTimeEntriesQuery + `)
+SELECT task_id,
+SUM(seconds) AS total_seconds,
+COALESCE(SUM(seconds) FILTER (WHERE billable), 0) AS billable_seconds,
+ROUND(COALESCE(SUM(seconds / 3600 * rate) FILTER (WHERE billable), 0), 2) AS amount
+FROM entry
 GROUP BY task_id
+ORDER BY total_seconds DESC`
+	// Entry with several tags is counted in every tag, entries without tags have NULL tag_id
+	getProjectMemberProductivityByTagQuery = `WITH entry AS (` + memberTimeEntriesQuery + `)
+SELECT time_entry_tag.tag_id,
+SUM(seconds) AS total_seconds,
+COALESCE(SUM(seconds) FILTER (WHERE billable), 0) AS billable_seconds,
+ROUND(COALESCE(SUM(seconds / 3600 * rate) FILTER (WHERE billable), 0), 2) AS amount
+FROM entry
+LEFT JOIN time_entry_tag ON time_entry_tag.time_entry_id = entry.id
+GROUP BY time_entry_tag.tag_id
 ORDER BY total_seconds DESC`
 )
@@ -0,0 +1,17 @@
+package repository
+
+const (
+	selectUserRatesQuery = `SELECT id, user_id, project_id, rate, to_char(effective_from, 'YYYY-MM-DD') AS effective_from
+FROM hourly_rate
+WHERE user_id = $1 AND project_id IS NULL
+ORDER BY effective_from DESC`
+	selectProjectRatesQuery = `SELECT id, user_id, project_id, rate, to_char(effective_from, 'YYYY-MM-DD') AS effective_from
+FROM hourly_rate
+WHERE project_id = $1
+ORDER BY user_id NULLS FIRST, effective_from DESC`
+	createRateQuery = `INSERT INTO hourly_rate (user_id, project_id, rate, effective_from)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, project_id, rate, to_char(effective_from, 'YYYY-MM-DD') AS effective_from`
+	// Workspace rates are deleted with zero projectID
+	deleteRateQuery = `DELETE FROM hourly_rate WHERE id = $1 AND project_id IS NOT DISTINCT FROM NULLIF($2::bigint, 0)`
+)
@@ -0,0 +1,76 @@
+package repository
+
+const (
+	// reportEntriesQuery selects entries started in the date range in timezone $1 matching filters.
+	// Admins see all entries, project owners see entries of their projects, members see their own entries.
+	// Rollup $11 reports entries of subtasks as entries of their top-level task, approved $12 selects entries
+	// of approved timesheet weeks only
+	reportEntriesQuery = `SELECT time_entry.id,
+       project.id                                  AS project_id,
+       project.name                                AS project_name,
+       report_task.id                              AS task_id,
+       report_task.name                            AS task_name,
+       time_entry.user_id,
+       concat_ws(' ', "user".name, "user".surname) AS user_name,
+       time_entry.started_at,
+       time_entry.ended_at,
+       time_entry.started_at AT TIME ZONE $1       AS local_started_at,
+       ROUND(EXTRACT(EPOCH FROM (COALESCE(time_entry.ended_at, now()) - time_entry.started_at)) - COALESCE(pause.seconds, 0))::bigint
+                                                   AS seconds,
+       time_entry.billable,
+       time_entry.description,
+       ARRAY(SELECT tag.name FROM time_entry_tag INNER JOIN tag ON tag.id = time_entry_tag.tag_id
+           WHERE time_entry_tag.time_entry_id = time_entry.id ORDER BY tag.name) AS tags
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id
+INNER JOIN task report_task ON report_task.id = CASE WHEN $11::bool THEN task_root_id(task.id) ELSE task.id END
+INNER JOIN project ON project.id = task.project_id
+INNER JOIN "user" ON "user".id = time_entry.user_id` + timeEntryPauseJoin + `WHERE (time_entry.started_at AT TIME ZONE $1)::date BETWEEN $2::date AND $3::date
+  AND (COALESCE(cardinality($4::bigint[]), 0) = 0 OR project.id = ANY($4))
+  AND (COALESCE(cardinality($5::bigint[]), 0) = 0 OR task.id = ANY($5) OR report_task.id = ANY($5))
+  AND (COALESCE(cardinality($6::bigint[]), 0) = 0 OR time_entry.user_id = ANY($6))
+  AND (COALESCE(cardinality($7::bigint[]), 0) = 0 OR EXISTS(SELECT FROM time_entry_tag
+      WHERE time_entry_id = time_entry.id AND tag_id = ANY($7)))
+  AND ($8::bool IS NULL OR time_entry.billable = $8)
+  AND ($9::bool OR project.creator_id = $10 OR time_entry.user_id = $10)
+  AND (NOT $12::bool OR ` + approvedTimesheetExists + `)`
+	selectReportEntriesQuery = reportEntriesQuery + `
+ORDER BY time_entry.started_at, time_entry.id`
+
+	// getReportQuery groups report entries by dimensions $13 and optional $14. Entry of the task with several labels
+	// is split evenly between them by the weight of the label dimension, entries of unlabeled tasks have empty label
+	getReportQuery = `WITH entry AS (` + reportEntriesQuery + `
+),
+dimension AS (
+SELECT entry.id, dim.dimension, dim.key, dim.name, 1::numeric AS weight
+FROM entry
+CROSS JOIN LATERAL (VALUES
+    ('project', entry.project_id::text, entry.project_name),
+    ('task', entry.task_id::text, entry.task_name),
+    ('user', entry.user_id::text, entry.user_name),
+    ('day', to_char(entry.local_started_at, 'YYYY-MM-DD'), to_char(entry.local_started_at, 'YYYY-MM-DD')),
+    ('week', to_char(date_trunc('week', entry.local_started_at), 'YYYY-MM-DD'), to_char(entry.local_started_at, 'IYYY-"W"IW')),
+    ('month', to_char(entry.local_started_at, 'YYYY-MM'), to_char(entry.local_started_at, 'YYYY-MM'))
+) dim(dimension, key, name)
+WHERE dim.dimension IN ($13::text, $14::text)
+UNION ALL
+SELECT entry.id, 'label', COALESCE(label.id::text, ''), COALESCE(label.name, ''),
+       1::numeric / GREATEST(count(label.id) OVER (PARTITION BY entry.id), 1)
+FROM entry
+LEFT JOIN task_label ON task_label.task_id = entry.task_id
+LEFT JOIN label ON label.id = task_label.label_id
+WHERE 'label' IN ($13::text, $14::text)
+)
+SELECT d1.key                                                                       AS key1,
+       d1.name                                                                      AS name1,
+       d2.key                                                                       AS key2,
+       d2.name                                                                      AS name2,
+       ROUND(SUM(entry.seconds * d1.weight * COALESCE(d2.weight, 1)))::bigint        AS total_seconds,
+       COALESCE(ROUND(SUM(entry.seconds * d1.weight * COALESCE(d2.weight, 1)) FILTER (WHERE entry.billable)), 0)::bigint
+                                                                                    AS billable_seconds
+FROM entry
+INNER JOIN dimension d1 ON d1.id = entry.id AND d1.dimension = $13
+LEFT JOIN dimension d2 ON d2.id = entry.id AND d2.dimension = $14
+GROUP BY d1.key, d1.name, d2.key, d2.name
+ORDER BY d1.name, d1.key, d2.name, d2.key`
+)
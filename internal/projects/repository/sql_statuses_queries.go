@@ -0,0 +1,31 @@
+package repository
+
+const (
+	taskStatusColumns = `task_status.id, task_status.project_id, task_status.name, task_status.category, task_status.position,
+ARRAY(SELECT to_status_id FROM task_status_transition
+      WHERE from_status_id = task_status.id ORDER BY to_status_id) AS transitions`
+	selectTaskStatusesQuery = `SELECT ` + taskStatusColumns + `
+FROM task_status
+WHERE project_id = $1
+ORDER BY position, id`
+	getTaskStatusQuery = `SELECT ` + taskStatusColumns + `
+FROM task_status
+WHERE project_id = $1 AND id = $2`
+	// New status is appended to the end of the workflow by default
+	createTaskStatusQuery = `INSERT INTO task_status (project_id, name, category, position)
+VALUES ($1, $2, $3, COALESCE($4, (SELECT COALESCE(MAX(position) + 1, 0) FROM task_status WHERE project_id = $1)))
+RETURNING id`
+	updateTaskStatusQuery = `UPDATE task_status SET
+name = COALESCE(NULLIF($3, ''), name),
+category = COALESCE(NULLIF($4, ''), category),
+position = COALESCE($5, position)
+WHERE project_id = $1 AND id = $2`
+	deleteTaskStatusTransitionsQuery = `DELETE FROM task_status_transition WHERE from_status_id = $1`
+	// Statuses of other projects are skipped
+	createTaskStatusTransitionsQuery = `INSERT INTO task_status_transition (from_status_id, to_status_id)
+SELECT $1, id FROM task_status WHERE project_id = $2 AND id = ANY($3::bigint[]) AND id <> $1`
+	countTaskStatusTasksQuery          = `SELECT count(1) FROM task WHERE status_id = $1`
+	deleteTaskStatusQuery              = `DELETE FROM task_status WHERE project_id = $1 AND id = $2`
+	isTaskStatusTransitionAllowedQuery = `SELECT NOT EXISTS(SELECT FROM task_status_transition WHERE from_status_id = $1)
+    OR EXISTS(SELECT FROM task_status_transition WHERE from_status_id = $1 AND to_status_id = $2)`
+)
@@ -0,0 +1,10 @@
+package repository
+
+const (
+	selectTagsQuery = `SELECT * FROM tag WHERE project_id = $1 ORDER BY name`
+	createTagQuery  = `INSERT INTO tag (project_id, name) VALUES ($1, $2) RETURNING *`
+	updateTagQuery  = `UPDATE tag SET name = $1
+WHERE id = $2 AND project_id = $3
+RETURNING *`
+	deleteTagQuery = `DELETE FROM tag WHERE id = $1 AND project_id = $2`
+)
@@ -1,30 +1,208 @@
 package repository
 
 const (
-	createTaskQuery = `INSERT INTO task (name, description, project_id) 
-VALUES ($1, $2, $3) RETURNING *`
-	getTotalTasks     = `SELECT COUNT(id) FROM task WHERE project_id = $1`
-	selectTasks       = `SELECT task.* FROM task WHERE project_id = $1`
+	createTaskQuery = `INSERT INTO task (name, description, project_id, billable, estimate_seconds, status_id, parent_id,
+                  start_at, due_at, priority)
+VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, 0), NULLIF($7, 0), $8, $9, $10) RETURNING *`
+	getTaskByIDQuery = `SELECT * FROM task WHERE id = $1`
+	// tasksFilterQuery selects tasks of the project $1 matching filters, empty filters aren't applied.
+	// Search $5 matches literally, its wildcards and escape characters are escaped
+	tasksFilterQuery = `FROM task
+WHERE task.project_id = $1
+  AND (COALESCE(cardinality($2::bigint[]), 0) = 0 OR task.status_id = ANY($2))
+  AND ($3::bool IS NULL OR EXISTS(SELECT FROM task_status WHERE task_status.id = task.status_id
+      AND (task_status.category = 'done') = $3))
+  AND ($4::bigint = 0 OR EXISTS(SELECT FROM task_participant WHERE task_id = task.id AND user_id = $4))
+  AND task.name ILIKE '%' || replace(replace(replace($5, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\'
+  AND ($6::timestamptz IS NULL OR task.created_at >= $6)
+  AND ($7::timestamptz IS NULL OR task.created_at < $7)
+  AND ($8::timestamptz IS NULL OR task.updated_at >= $8)
+  AND ($9::timestamptz IS NULL OR task.updated_at < $9)
+  AND ($10::bigint IS NULL OR task.parent_id IS NOT DISTINCT FROM NULLIF($10, 0))
+  AND (COALESCE(cardinality($11::bigint[]), 0) = 0
+    OR NOT $12::bool AND EXISTS(SELECT FROM task_label WHERE task_id = task.id AND label_id = ANY($11))
+    OR $12::bool AND NOT EXISTS(SELECT FROM unnest($11::bigint[]) label(id)
+        WHERE NOT EXISTS(SELECT FROM task_label WHERE task_id = task.id AND label_id = label.id)))`
+	getTotalTasks     = `SELECT COUNT(task.id) ` + tasksFilterQuery
 	isTaskMemberQuery = `SELECT FROM task_participant WHERE task_id = $1 AND user_id = $2 LIMIT 1`
 	updateTaskQuery   = `UPDATE task SET
 name = COALESCE(NULLIF($1, ''), name),
-description = COALESCE(NULLIF($2, ''), description)
-WHERE id = $3
+description = COALESCE(NULLIF($2, ''), description),
+billable = COALESCE($3, billable),
+estimate_seconds = CASE WHEN $4::bigint = 0 THEN NULL ELSE COALESCE($4, estimate_seconds) END,
+parent_id = CASE WHEN $5::bigint = 0 THEN NULL ELSE COALESCE($5, parent_id) END,
+start_at = CASE WHEN $6::bool THEN NULL ELSE COALESCE($7, start_at) END,
+due_at = CASE WHEN $8::bool THEN NULL ELSE COALESCE($9, due_at) END,
+priority = COALESCE(NULLIF($10, 0), priority)
+WHERE id = $11
 RETURNING *`
-	deleteTaskQuery = `DELETE FROM task WHERE id = $1`
-	startTaskQuery  = `INSERT INTO time_entry (task_id, user_id, started_at, ended_at)
-VALUES ($1, $2, now(), null)`
+	// moveTaskAfterQuery returns rank of the sibling $1 and the next task of its column except the moved task $2
+	moveTaskAfterQuery = `SELECT sibling.status_id, sibling.rank AS prev,
+COALESCE((SELECT rank FROM task WHERE status_id = sibling.status_id AND id <> $2 AND rank > sibling.rank
+          ORDER BY rank LIMIT 1), '') AS next
+FROM task sibling WHERE sibling.id = $1`
+	// moveTaskBeforeQuery returns rank of the sibling $1 and the previous task of its column except the moved task $2
+	moveTaskBeforeQuery = `SELECT sibling.status_id,
+COALESCE((SELECT rank FROM task WHERE status_id = sibling.status_id AND id <> $2 AND rank < sibling.rank
+          ORDER BY rank DESC LIMIT 1), '') AS prev, sibling.rank AS next
+FROM task sibling WHERE sibling.id = $1`
+	// lockTaskStatusQuery locks status of the task $1 to serialize rank changes within the column, see set_task_rank
+	lockTaskStatusQuery  = `SELECT FROM task_status WHERE id = (SELECT status_id FROM task WHERE id = $1) FOR NO KEY UPDATE`
+	setTaskRankQuery     = `UPDATE task SET rank = $2 WHERE id = $1 AND status_id = $3 RETURNING *`
+	deleteTaskQuery      = `DELETE FROM task WHERE id = $1`
+	setTaskStatusQuery   = `UPDATE task SET status_id = $2 WHERE id = $1 RETURNING *`
+	stopTaskEntriesQuery = `UPDATE time_entry SET ended_at = now() WHERE ended_at IS NULL AND task_id = $1`
+	// stopDoneTaskEntriesQuery stops running entries of the task $1 if it's done
+	stopDoneTaskEntriesQuery = `UPDATE time_entry SET ended_at = now()
+WHERE ended_at IS NULL AND task_id = $1
+  AND EXISTS(SELECT FROM task INNER JOIN task_status ON task_status.id = task.status_id
+      WHERE task.id = $1 AND task_status.category = 'done')`
+	// startTaskInProgressQuery moves todo task to the first in progress status if the project asks for it
+	// and the workflow allows the transition
+	startTaskInProgressQuery = `UPDATE task SET status_id = next.id
+FROM task_status current, project,
+     LATERAL (SELECT id FROM task_status
+              WHERE task_status.project_id = project.id AND task_status.category = 'in_progress'
+              ORDER BY task_status.position, task_status.id
+              LIMIT 1) next
+WHERE task.id = $1
+  AND current.id = task.status_id
+  AND current.category = 'todo'
+  AND project.id = task.project_id
+  AND project.start_in_progress
+  AND (NOT EXISTS(SELECT FROM task_status_transition WHERE from_status_id = current.id)
+    OR EXISTS(SELECT FROM task_status_transition WHERE from_status_id = current.id AND to_status_id = next.id))`
+	startTaskQuery = `INSERT INTO time_entry (task_id, user_id, started_at, ended_at, description, billable)
+VALUES ($1, $2, now(), null, $3, $4) RETURNING id`
 	endTaskQuery = `UPDATE time_entry SET ended_at = now()
 WHERE ended_at IS NULL AND task_id = $1 AND user_id = $2`
+	pauseTaskQuery = `INSERT INTO time_entry_pause (time_entry_id, started_at)
+SELECT id, now() FROM time_entry WHERE ended_at IS NULL AND task_id = $1 AND user_id = $2`
+	resumeTaskQuery = `UPDATE time_entry_pause SET ended_at = now()
+WHERE ended_at IS NULL
+  AND time_entry_id IN (SELECT id FROM time_entry WHERE ended_at IS NULL AND task_id = $1 AND user_id = $2)`
 	getActiveUserTasksQuery = `SELECT count(1) FROM task
 INNER JOIN time_entry on time_entry.task_id = task.id
 WHERE time_entry.ended_at IS NULL
 AND time_entry.user_id = $1
 AND task.project_id = (SELECT project_id FROM task WHERE id = $2)`
-	getTotalTaskMembersQuery = `SELECT count(user_id) FROM task_participant WHERE task_id = $1`
-	getTaskMembersQuery      = `SELECT user.* FROM "user"
+	getActiveUserTimeEntriesQuery = `SELECT count(1) FROM time_entry WHERE ended_at IS NULL AND user_id = $1`
+	lockUserTimeEntriesQuery      = `SELECT pg_advisory_xact_lock($1)`
+	getTotalTaskMembersQuery      = `SELECT count(user_id) FROM task_participant WHERE task_id = $1`
+	getTaskMembersQuery           = `SELECT "user".* FROM "user"
 INNER JOIN task_participant ON task_participant.user_id = "user".id
 WHERE task_id = $1`
+	getTaskMemberIDsQuery = `SELECT user_id FROM task_participant WHERE task_id = $1 ORDER BY user_id`
 	addTaskMemberQuery    = `INSERT INTO task_participant (task_id, user_id) VALUES ($1, $2)`
 	deleteTaskMemberQuery = `DELETE FROM task_participant WHERE task_id = $1 AND user_id = $2`
+	// taskEstimatesQuery sums worked seconds of all entries of the task, running entries are counted until now
+	taskEstimatesQuery = `SELECT task.id AS task_id, task.name, task.estimate_seconds,
+       actual.seconds AS actual_seconds,
+       GREATEST(task.estimate_seconds - actual.seconds, 0) AS remaining_seconds,
+       actual.seconds - task.estimate_seconds AS variance_seconds
+FROM task
+CROSS JOIN LATERAL (SELECT COALESCE(SUM(EXTRACT(EPOCH FROM (COALESCE(time_entry.ended_at, now()) - time_entry.started_at))
+    - COALESCE(pause.seconds, 0)), 0)::bigint AS seconds
+    FROM time_entry` + timeEntryPauseJoin + `    WHERE time_entry.task_id = task.id) actual
+WHERE task.project_id = $1
+`
+	selectTaskEstimatesQuery     = taskEstimatesQuery + `ORDER BY task.id`
+	selectOverEstimateTasksQuery = taskEstimatesQuery + `AND actual.seconds > task.estimate_seconds
+ORDER BY variance_seconds DESC, task.id`
+	// lockTaskTreeQuery locks the project of the task $1, parent changes of tasks of the project are serialized by it
+	lockTaskTreeQuery = `SELECT FROM project WHERE id = (SELECT project_id FROM task WHERE id = $1) FOR NO KEY UPDATE`
+	// subtasksQuery is the task $1 with all its subtasks. Recursion stops at cycles, the repeated task is marked is_cycle
+	subtasksQuery = `WITH RECURSIVE subtask AS (SELECT id, 0 AS depth FROM task WHERE id = $1
+    UNION ALL
+    SELECT task.id, subtask.depth + 1 FROM task INNER JOIN subtask ON task.parent_id = subtask.id)
+    CYCLE id SET is_cycle USING path
+`
+	// ancestorsQuery is the task $1 with all its ancestors. Recursion stops at cycles, the repeated task is marked is_cycle
+	ancestorsQuery = `WITH RECURSIVE ancestor AS (SELECT id, parent_id, 0 AS depth FROM task WHERE id = $1
+    UNION ALL
+    SELECT task.id, task.parent_id, ancestor.depth + 1 FROM task INNER JOIN ancestor ON task.id = ancestor.parent_id)
+    CYCLE id SET is_cycle USING path
+`
+	getTaskDepthQuery       = ancestorsQuery + `SELECT max(depth) FROM ancestor`
+	getTaskAncestorIDsQuery = ancestorsQuery + `SELECT id FROM ancestor WHERE depth > 0 AND NOT is_cycle ORDER BY depth`
+	getSubtasksHeightQuery  = subtasksQuery + `SELECT max(depth) FROM subtask`
+	isSubtaskQuery          = subtasksQuery + `SELECT EXISTS(SELECT FROM subtask WHERE id = $2 AND depth > 0)`
+	selectChildTasksQuery   = `SELECT * FROM task WHERE parent_id = $1 ORDER BY id`
+	selectTaskLabelsQuery   = `SELECT label.* FROM label
+INNER JOIN task_label ON task_label.label_id = label.id
+WHERE task_label.task_id = $1
+ORDER BY label.name`
+	// getTaskMembersTimeQuery sums worked seconds of the task and its subtasks per user, running entries are counted until now
+	getTaskMembersTimeQuery = subtasksQuery + `SELECT user_id,
+SUM(seconds)::bigint AS total_seconds,
+COALESCE(SUM(seconds) FILTER (WHERE billable), 0)::bigint AS billable_seconds,
+COALESCE(SUM(seconds) FILTER (WHERE task_id = $1), 0)::bigint AS own_seconds,
+count(1) AS entries_count
+FROM (SELECT time_entry.user_id, time_entry.task_id, time_entry.billable,
+    EXTRACT(EPOCH FROM (COALESCE(time_entry.ended_at, now()) - time_entry.started_at)) - COALESCE(pause.seconds, 0)
+    AS seconds
+    FROM time_entry` + timeEntryPauseJoin + `    WHERE time_entry.task_id IN (SELECT id FROM subtask)) entry
+GROUP BY user_id
+ORDER BY total_seconds DESC, user_id`
+	getTaskRunningEntriesQuery = `SELECT time_entry.*, task.name AS task_name, project.id AS project_id,
+project.name AS project_name,
+EXISTS(SELECT FROM time_entry_pause WHERE time_entry_id = time_entry.id AND ended_at IS NULL) AS paused
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id
+INNER JOIN project ON project.id = task.project_id
+WHERE time_entry.task_id = $1 AND time_entry.ended_at IS NULL
+ORDER BY time_entry.started_at`
+	// selectDueTasksQuery selects unfinished tasks of the user due in $2 seconds, overdue ones included
+	selectDueTasksQuery = `SELECT task.* FROM task
+INNER JOIN task_participant ON task_participant.task_id = task.id
+INNER JOIN task_status ON task_status.id = task.status_id
+WHERE task_participant.user_id = $1
+  AND task_status.category <> 'done'
+  AND task.due_at <= now() + $2 * interval '1 second'
+ORDER BY task.due_at, task.id`
+	// claimDueRemindersQuery marks unfinished tasks due in $1 seconds as reminded and returns ones not reminded yet
+	claimDueRemindersQuery = `WITH claimed AS (INSERT INTO task_due_reminder (task_id, due_at)
+    SELECT task.id, task.due_at FROM task
+    INNER JOIN task_status ON task_status.id = task.status_id
+    WHERE task_status.category <> 'done'
+      AND task.due_at > now()
+      AND task.due_at <= now() + $1 * interval '1 second'
+    ON CONFLICT DO NOTHING
+    RETURNING task_id)
+SELECT task.* FROM task WHERE id IN (SELECT task_id FROM claimed) ORDER BY task.due_at, task.id`
 )
+
+// selectTasksQueries are pages of filtered tasks by sort order. Page starts after the cursor $13 with task id $14
+var selectTasksQueries = map[string]string{
+	"board":       selectTasksBoardQuery,
+	"id":          selectTasksPageQuery("task.id", "bigint", false),
+	"-id":         selectTasksPageQuery("task.id", "bigint", true),
+	"name":        selectTasksPageQuery("task.name", "text", false),
+	"-name":       selectTasksPageQuery("task.name", "text", true),
+	"created_at":  selectTasksPageQuery("task.created_at", "timestamptz", false),
+	"-created_at": selectTasksPageQuery("task.created_at", "timestamptz", true),
+	"updated_at":  selectTasksPageQuery("task.updated_at", "timestamptz", false),
+	"-updated_at": selectTasksPageQuery("task.updated_at", "timestamptz", true),
+	"priority":    selectTasksPageQuery("task.priority", "smallint", false),
+	"-priority":   selectTasksPageQuery("task.priority", "smallint", true),
+}
+
+// selectTasksBoardQuery orders tasks by position of their status and rank within it.
+// The cursor is status id and rank separated by slash, status position is looked up as ranks are compared per status
+var selectTasksBoardQuery = `SELECT task.* ` + tasksFilterQuery + `
+  AND ($13::text IS NULL OR ((SELECT position FROM task_status WHERE id = task.status_id), task.status_id, task.rank, task.id) >
+    ((SELECT position FROM task_status WHERE id = split_part($13, '/', 1)::bigint), split_part($13, '/', 1)::bigint,
+     split_part($13, '/', 2) COLLATE "C", $14::bigint))
+ORDER BY (SELECT position FROM task_status WHERE id = task.status_id), task.status_id, task.rank, task.id
+LIMIT $15`
+
+func selectTasksPageQuery(column, cast string, desc bool) string {
+	op, order := ">", "ASC"
+	if desc {
+		op, order = "<", "DESC"
+	}
+	return `SELECT task.* ` + tasksFilterQuery + `
+  AND ($13::text IS NULL OR (` + column + `, task.id) ` + op + ` (($13::text)::` + cast + `, $14::bigint))
+ORDER BY ` + column + ` ` + order + `, task.id ` + order + `
+LIMIT $15`
+}
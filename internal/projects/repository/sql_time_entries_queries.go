@@ -0,0 +1,97 @@
+package repository
+
+const (
+	selectTimeEntriesQuery = `SELECT time_entry.*,
+ARRAY(SELECT tag_id FROM time_entry_tag WHERE time_entry_id = time_entry.id) AS tag_ids
+FROM time_entry
+WHERE task_id = $1
+  AND user_id = COALESCE(NULLIF($2, 0), user_id)
+  AND started_at >= COALESCE($3::timestamptz, '-infinity')
+  AND started_at < COALESCE($4::timestamptz, 'infinity')
+  AND ($5::bigint = 0 OR EXISTS(SELECT FROM time_entry_tag WHERE time_entry_id = time_entry.id AND tag_id = $5))
+ORDER BY started_at DESC`
+	getTimeEntryByIDQuery = `SELECT time_entry.*,
+ARRAY(SELECT tag_id FROM time_entry_tag WHERE time_entry_id = time_entry.id) AS tag_ids
+FROM time_entry WHERE id = $1 AND task_id = $2`
+	createTimeEntryQuery = `INSERT INTO time_entry (task_id, user_id, started_at, ended_at, description, billable)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	updateTimeEntryQuery = `UPDATE time_entry SET
+task_id = $1,
+started_at = $2,
+auto_stopped = auto_stopped AND ended_at IS NOT DISTINCT FROM $3,
+ended_at = $3,
+description = $4,
+billable = COALESCE($5, billable)
+WHERE id = $6 AND invoice_id IS NULL
+RETURNING *`
+	// deleteOuterTimeEntryPausesQuery deletes pauses of the entry $1 outside of its range $2 - $3,
+	// clipTimeEntryPausesQuery cuts the rest to the range
+	deleteOuterTimeEntryPausesQuery = `DELETE FROM time_entry_pause
+WHERE time_entry_id = $1 AND (ended_at <= $2 OR started_at >= $3::timestamptz)`
+	clipTimeEntryPausesQuery = `UPDATE time_entry_pause SET
+started_at = GREATEST(started_at, $2),
+ended_at = LEAST(ended_at, $3::timestamptz)
+WHERE time_entry_id = $1`
+	deleteTimeEntryTagsQuery = `DELETE FROM time_entry_tag WHERE time_entry_id = $1`
+	addTimeEntryTagsQuery    = `INSERT INTO time_entry_tag (time_entry_id, tag_id)
+SELECT DISTINCT time_entry.id, tag.id FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id
+INNER JOIN tag ON tag.project_id = task.project_id
+WHERE time_entry.id = $1 AND tag.id = ANY($2::bigint[])`
+	deleteTimeEntryQuery = `DELETE FROM time_entry WHERE id = $1 AND task_id = $2 AND invoice_id IS NULL`
+
+	isProjectTaskQuery           = `SELECT FROM task WHERE project_id = $1 AND id = $2`
+	countOverlappingEntriesQuery = `SELECT count(1) FROM time_entry
+WHERE user_id = $1
+  AND id <> $2
+  AND started_at < COALESCE($4::timestamptz, 'infinity')
+  AND COALESCE(ended_at, 'infinity') > $3`
+
+	getActiveTimeEntriesQuery = `SELECT time_entry.*, task.name AS task_name, project.id AS project_id,
+project.name AS project_name,
+EXISTS(SELECT FROM time_entry_pause WHERE time_entry_id = time_entry.id AND ended_at IS NULL) AS paused
+FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id
+INNER JOIN project ON project.id = task.project_id
+WHERE time_entry.user_id = $1 AND time_entry.ended_at IS NULL
+ORDER BY time_entry.started_at DESC`
+	stopUserTimeEntriesQuery = `UPDATE time_entry SET ended_at = now() WHERE ended_at IS NULL AND user_id = $1`
+	startTimeEntryQuery      = `INSERT INTO time_entry (task_id, user_id, started_at, ended_at)
+VALUES ($1, $2, now(), null) RETURNING *`
+
+	// Running entry is stopped at the end of the user's workday or after max duration, whichever comes first.
+	// Entry started after the end of the workday is stopped at the end of the next one
+	autoStopTimeEntriesQuery = `UPDATE time_entry SET ended_at = limits.ended_at, auto_stopped = true
+FROM (SELECT id, LEAST(max_ended_at, workday_ended_at) AS ended_at
+      FROM (SELECT time_entry.id,
+                   time_entry.started_at + NULLIF($1, 0) * interval '1 second' AS max_ended_at,
+                   CASE WHEN workday.ended_at > time_entry.started_at THEN workday.ended_at
+                        ELSE workday.ended_at + interval '1 day' END           AS workday_ended_at
+            FROM time_entry
+            LEFT JOIN user_timer_settings ON user_timer_settings.user_id = time_entry.user_id
+            LEFT JOIN LATERAL (SELECT ((time_entry.started_at AT TIME ZONE user_timer_settings.timezone)::date +
+                                       user_timer_settings.workday_end) AT TIME ZONE
+                                      user_timer_settings.timezone AS ended_at) workday ON true
+            WHERE time_entry.ended_at IS NULL) candidates) limits
+WHERE time_entry.id = limits.id
+  AND limits.ended_at <= now()
+RETURNING time_entry.id, time_entry.started_at, time_entry.ended_at`
+
+	getTimerSettingsQuery = `SELECT user_id, to_char(workday_end, 'HH24:MI') AS workday_end, timezone
+FROM user_timer_settings WHERE user_id = $1`
+	upsertTimerSettingsQuery = `INSERT INTO user_timer_settings (user_id, workday_end, timezone)
+VALUES ($1, $2::time, COALESCE(NULLIF($3, ''), 'UTC'))
+ON CONFLICT (user_id) DO UPDATE SET workday_end = excluded.workday_end, timezone = excluded.timezone
+RETURNING user_id, to_char(workday_end, 'HH24:MI') AS workday_end, timezone`
+	isTimeEntryApprovedQuery = `SELECT EXISTS(SELECT FROM timesheet
+    INNER JOIN task ON task.project_id = timesheet.project_id
+    WHERE task.id = $1
+      AND timesheet.user_id = $2
+      AND timesheet.week_start = date_trunc('week', $3::timestamptz)::date
+      AND timesheet.status = 'approved')`
+	// Entries started on the lock date or earlier are locked
+	isTimeEntryLockedQuery = `SELECT EXISTS(SELECT FROM period_lock
+    INNER JOIN task ON task.id = $1
+    WHERE (period_lock.project_id IS NULL OR period_lock.project_id = task.project_id)
+      AND $2::timestamptz < period_lock.locked_until + 1)`
+)
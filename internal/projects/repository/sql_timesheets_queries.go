@@ -0,0 +1,53 @@
+package repository
+
+const (
+	// timesheetColumns counts worked seconds of the member in the project during the week
+	timesheetColumns = `timesheet.*, COALESCE(work.seconds, 0)::bigint AS total_seconds
+FROM timesheet
+LEFT JOIN LATERAL (SELECT ROUND(SUM(
+        EXTRACT(EPOCH FROM (COALESCE(time_entry.ended_at, now()) - time_entry.started_at)) - COALESCE(pause.seconds, 0)
+    )) AS seconds
+    FROM time_entry
+    INNER JOIN task ON task.id = time_entry.task_id` + timeEntryPauseJoin + `    WHERE task.project_id = timesheet.project_id
+      AND time_entry.user_id = timesheet.user_id
+      AND time_entry.started_at >= timesheet.week_start
+      AND time_entry.started_at < timesheet.week_start + 7) work ON true`
+	selectProjectTimesheetsQuery = `SELECT ` + timesheetColumns + `
+WHERE timesheet.project_id = $1
+  AND timesheet.user_id = COALESCE(NULLIF($2, 0), timesheet.user_id)
+  AND timesheet.status = COALESCE(NULLIF($3, ''), timesheet.status)
+ORDER BY timesheet.week_start DESC, timesheet.user_id`
+	// Admins approve timesheets of all projects, owners approve timesheets of their projects
+	selectPendingTimesheetsQuery = `SELECT ` + timesheetColumns + `
+INNER JOIN project ON project.id = timesheet.project_id
+WHERE timesheet.status = 'submitted'
+  AND ($1::bool OR project.creator_id = $2)
+ORDER BY timesheet.submitted_at`
+	getTimesheetByIDQuery = `SELECT ` + timesheetColumns + `
+WHERE timesheet.id = $1 AND timesheet.project_id = $2`
+
+	// Rejected timesheet is submitted again, submitted and approved ones are left untouched
+	submitTimesheetQuery = `INSERT INTO timesheet (project_id, user_id, week_start) VALUES ($1, $2, $3)
+ON CONFLICT (project_id, user_id, week_start) DO UPDATE SET
+status = 'submitted',
+comment = NULL,
+submitted_at = now(),
+reviewed_by = NULL,
+reviewed_at = NULL
+WHERE timesheet.status = 'rejected'
+RETURNING id`
+	// hasRunningTimesheetEntriesQuery checks running entries of the member in the project during the week
+	hasRunningTimesheetEntriesQuery = `SELECT EXISTS(SELECT FROM time_entry
+INNER JOIN task ON task.id = time_entry.task_id
+WHERE task.project_id = $1
+  AND time_entry.user_id = $2
+  AND time_entry.ended_at IS NULL
+  AND time_entry.started_at >= $3::date
+  AND time_entry.started_at < $3::date + 7)`
+	reviewTimesheetQuery = `UPDATE timesheet SET
+status = $1,
+comment = $2,
+reviewed_by = $3,
+reviewed_at = now()
+WHERE id = $4 AND project_id = $5 AND status = 'submitted'`
+)
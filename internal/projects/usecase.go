@@ -3,6 +3,9 @@ package projects
 import (
 	"context"
 	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"io"
+	"time"
 )
 
 type UseCase interface {
@@ -17,20 +20,143 @@ type UseCase interface {
 	GetMembers(ctx context.Context, projectID int64) ([]*models.User, error)
 	AddMember(ctx context.Context, projectID, userID int64) error
 	RemoveMember(ctx context.Context, projectID, userID int64) error
-	GetMemberProductivity(ctx context.Context, projectID, userID int64) ([]models.UserProductivity, error)
+	GetMemberProductivity(ctx context.Context, projectID, userID int64, query *utils.ProductivityQuery) ([]models.UserProductivity, error)
 }
 
 type TasksUseCase interface {
-	Get(ctx context.Context, projectID int64) ([]*models.Task, error)
+	Get(ctx context.Context, projectID int64, query *utils.TasksQuery) (utils.TasksQueryResponse, error)
+	GetByID(ctx context.Context, projectID, taskID int64) (*models.TaskDetail, error)
 	Create(ctx context.Context, task *models.Task) (*models.Task, error)
 	Update(ctx context.Context, task *models.Task) (*models.Task, error)
+	Move(ctx context.Context, projectID, taskID, siblingID int64, after bool) (*models.Task, error)
+	Bulk(ctx context.Context, user *models.User, projectID int64, batch *utils.BulkTasksRequest) (*utils.BulkTasksResponse, error)
 	Delete(ctx context.Context, taskID int64) error
 
-	Start(ctx context.Context, taskID, userID int64) error
+	GetEstimates(ctx context.Context, projectID int64) (*models.ProjectEstimate, error)
+	GetOverEstimate(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error)
+	GetDue(ctx context.Context, userID int64, within time.Duration) (*models.DueTasks, error)
+	RemindDue(ctx context.Context, before time.Duration) (int64, error)
+
+	Start(ctx context.Context, entry *models.TimeEntry) ([]*models.DependencyTask, error)
 	Stop(ctx context.Context, taskID, userID int64) error
+	Pause(ctx context.Context, taskID, userID int64) error
+	Resume(ctx context.Context, taskID, userID int64) error
 
 	GetMembers(ctx context.Context, taskID int64) ([]*models.User, error)
 	AddMember(ctx context.Context, taskID, userID int64) error
 	DeleteMember(ctx context.Context, taskID, userID int64) error
 	IsMember(ctx context.Context, taskID, userID int64) error
 }
+
+type TimeEntriesUseCase interface {
+	Get(ctx context.Context, projectID, taskID int64, query *utils.TimeEntriesQuery) ([]*models.TimeEntry, error)
+	Export(ctx context.Context, projectID, taskID int64, query *utils.TimeEntriesQuery,
+		fn func(entry *models.TimeEntry) error) error
+	GetByID(ctx context.Context, projectID, taskID, entryID int64) (*models.TimeEntry, error)
+	Create(ctx context.Context, projectID int64, entry *models.TimeEntry) (*models.TimeEntry, error)
+	Update(ctx context.Context, user *models.User, projectID, taskID int64, updates *models.TimeEntry) (*models.TimeEntry, error)
+	Delete(ctx context.Context, user *models.User, projectID, taskID, entryID int64) error
+
+	GetActive(ctx context.Context, userID int64) ([]*models.ActiveTimeEntry, error)
+	Switch(ctx context.Context, user *models.User, taskID int64) (*models.TimeEntry, []*models.DependencyTask, error)
+	AutoStop(ctx context.Context, maxDuration time.Duration) (int64, error)
+
+	GetSettings(ctx context.Context, userID int64) (*models.TimerSettings, error)
+	UpdateSettings(ctx context.Context, settings *models.TimerSettings) (*models.TimerSettings, error)
+}
+
+type LabelsUseCase interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Label, error)
+	Create(ctx context.Context, label *models.Label) (*models.Label, error)
+	Update(ctx context.Context, label *models.Label) (*models.Label, error)
+	Delete(ctx context.Context, projectID, labelID int64) error
+	Attach(ctx context.Context, projectID, taskID, labelID int64) error
+	Detach(ctx context.Context, projectID, taskID, labelID int64) error
+}
+
+type TagsUseCase interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Tag, error)
+	Create(ctx context.Context, tag *models.Tag) (*models.Tag, error)
+	Update(ctx context.Context, tag *models.Tag) (*models.Tag, error)
+	Delete(ctx context.Context, projectID, tagID int64) error
+}
+
+type InvoicesUseCase interface {
+	Get(ctx context.Context, projectID int64) ([]*models.Invoice, error)
+	GetByID(ctx context.Context, projectID, invoiceID int64) (*models.Invoice, error)
+	GetPDF(ctx context.Context, projectID, invoiceID int64) ([]byte, error)
+	Create(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error)
+	UpdateStatus(ctx context.Context, projectID, invoiceID int64, status string) (*models.Invoice, error)
+	Delete(ctx context.Context, projectID, invoiceID int64) error
+}
+
+type RatesUseCase interface {
+	GetUserRates(ctx context.Context, userID int64) ([]*models.HourlyRate, error)
+	GetProjectRates(ctx context.Context, projectID int64) ([]*models.HourlyRate, error)
+	Create(ctx context.Context, rate *models.HourlyRate) (*models.HourlyRate, error)
+	Delete(ctx context.Context, projectID, rateID int64) error
+}
+
+type TimesheetsUseCase interface {
+	Get(ctx context.Context, projectID int64, query *utils.TimesheetsQuery) ([]*models.Timesheet, error)
+	GetPending(ctx context.Context, user *models.User) ([]*models.Timesheet, error)
+	Submit(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error)
+	Approve(ctx context.Context, user *models.User, projectID, timesheetID int64, comment *string) (*models.Timesheet, error)
+	Reject(ctx context.Context, user *models.User, projectID, timesheetID int64, comment string) (*models.Timesheet, error)
+}
+
+type LocksUseCase interface {
+	Get(ctx context.Context) ([]*models.PeriodLock, error)
+	GetByProject(ctx context.Context, projectID int64) (*models.PeriodLock, error)
+	Set(ctx context.Context, lock *models.PeriodLock) (*models.PeriodLock, error)
+	Delete(ctx context.Context, projectID int64) error
+}
+
+type ReportsUseCase interface {
+	Get(ctx context.Context, user *models.User, query *utils.ReportQuery) (*models.Report, error)
+	Export(ctx context.Context, user *models.User, query *utils.ReportQuery, fn func(row *models.ReportRow) error) error
+	GetEntries(ctx context.Context, user *models.User, filter *utils.ReportFilter) ([]*models.ReportEntry, error)
+	ExportEntries(ctx context.Context, user *models.User, filter *utils.ReportFilter, fn func(entry *models.ReportEntry) error) error
+}
+
+type BudgetsUseCase interface {
+	GetByProject(ctx context.Context, projectID int64) (*models.ProjectBudget, error)
+	Set(ctx context.Context, budget *models.ProjectBudget) (*models.ProjectBudget, error)
+	Delete(ctx context.Context, projectID int64) error
+	GetConsumption(ctx context.Context, projectID int64) (*models.BudgetConsumption, error)
+}
+
+type NotificationsUseCase interface {
+	Get(ctx context.Context, userID int64, query *utils.NotificationsQuery) ([]*models.Notification, error)
+	Read(ctx context.Context, userID, notificationID int64) (*models.Notification, error)
+	ReadAll(ctx context.Context, userID int64) (int64, error)
+}
+
+type StatusesUseCase interface {
+	Get(ctx context.Context, projectID int64) ([]*models.TaskStatus, error)
+	Create(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error)
+	Update(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error)
+	Delete(ctx context.Context, projectID, statusID int64) error
+}
+
+type DependenciesUseCase interface {
+	Get(ctx context.Context, taskID int64) ([]*models.DependencyTask, error)
+	Create(ctx context.Context, projectID int64, dependency *models.TaskDependency) error
+	Delete(ctx context.Context, taskID, blockerID int64) error
+	GetGraph(ctx context.Context, projectID int64) (*models.DependencyGraph, error)
+}
+
+type CommentsUseCase interface {
+	Get(ctx context.Context, taskID int64, query *utils.CommentsQuery) ([]*models.TaskComment, error)
+	Create(ctx context.Context, projectID int64, comment *models.TaskComment) (*models.TaskComment, error)
+	Update(ctx context.Context, user *models.User, projectID int64, updates *models.TaskComment) (*models.TaskComment, error)
+	Delete(ctx context.Context, user *models.User, projectID, taskID, commentID int64) error
+}
+
+type AttachmentsUseCase interface {
+	Get(ctx context.Context, taskID int64) ([]*models.TaskAttachment, error)
+	Upload(ctx context.Context, projectID int64, attachment *models.TaskAttachment, file io.Reader) (*models.TaskAttachment, error)
+	Download(ctx context.Context, projectID, taskID, attachmentID int64) (*models.TaskAttachment, io.ReadCloser, error)
+	Delete(ctx context.Context, user *models.User, projectID, taskID, attachmentID int64) error
+	CleanupOrphans(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/armanokka/time_tracker/config"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/storage"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+)
+
+// sniffLen is the number of bytes http.DetectContentType considers
+const sniffLen = 512
+
+// orphansBatchSize is the number of stored files of deleted attachments removed at once
+const orphansBatchSize = 100
+
+type attachmentsUC struct {
+	cfg             config.AttachmentsConfig
+	attachmentsRepo projects.AttachmentsRepository
+	tasksRepo       projects.TasksRepository
+	projectsRepo    projects.Repository
+	storage         storage.Storage
+	tracer          trace.Tracer
+}
+
+func NewAttachmentsUseCase(cfg config.AttachmentsConfig, attachmentsRepo projects.AttachmentsRepository,
+	tasksRepo projects.TasksRepository, projectsRepo projects.Repository, storage storage.Storage) projects.AttachmentsUseCase {
+	return attachmentsUC{
+		cfg:             cfg,
+		attachmentsRepo: attachmentsRepo,
+		tasksRepo:       tasksRepo,
+		projectsRepo:    projectsRepo,
+		storage:         storage,
+		tracer:          otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (a attachmentsUC) Get(ctx context.Context, taskID int64) ([]*models.TaskAttachment, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsUC.Get")
+	defer span.End()
+
+	return a.attachmentsRepo.Get(ctx, taskID)
+}
+
+// Upload stores the file and attaches it to the task. Content type is detected from the file contents,
+// the header sent by the client isn't trusted
+func (a attachmentsUC) Upload(ctx context.Context, projectID int64, attachment *models.TaskAttachment,
+	file io.Reader) (*models.TaskAttachment, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsUC.Upload")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, a.tasksRepo, projectID, attachment.TaskID); err != nil {
+		return nil, err
+	}
+	if attachment.Size > a.cfg.MaxSize {
+		return nil, httpErrors.NewRestError(http.StatusRequestEntityTooLarge, httpErrors.FileTooLarge.Error(),
+			fmt.Sprintf("file size exceeds %d bytes", a.cfg.MaxSize))
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	head = head[:n]
+	attachment.ContentType = http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(attachment.ContentType)
+	if err != nil || !slices.Contains(a.cfg.AllowedTypes, mediaType) {
+		return nil, httpErrors.NewRestError(http.StatusUnsupportedMediaType, httpErrors.NotAllowedFileType.Error(),
+			attachment.ContentType)
+	}
+
+	key := make([]byte, 16)
+	if _, err = rand.Read(key); err != nil {
+		return nil, err
+	}
+	attachment.StorageKey = fmt.Sprintf("tasks/%d/%s", attachment.TaskID, hex.EncodeToString(key))
+	if err = a.storage.Put(ctx, attachment.StorageKey, io.MultiReader(bytes.NewReader(head), file), attachment.Size,
+		attachment.ContentType); err != nil {
+		return nil, err
+	}
+
+	created, err := a.attachmentsRepo.Create(ctx, attachment)
+	if err != nil {
+		// Stored file isn't referenced by anything, so it's removed
+		return nil, errors.Join(err, a.storage.Delete(ctx, attachment.StorageKey))
+	}
+	return created, nil
+}
+
+// Download returns the attachment and its contents, the caller must close them
+func (a attachmentsUC) Download(ctx context.Context, projectID, taskID, attachmentID int64) (*models.TaskAttachment,
+	io.ReadCloser, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsUC.Download")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, a.tasksRepo, projectID, taskID); err != nil {
+		return nil, nil, err
+	}
+	attachment, err := a.attachmentsRepo.GetByID(ctx, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := a.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, file, nil
+}
+
+// Delete removes the attachment, it's allowed to its uploader, project owner and admins
+func (a attachmentsUC) Delete(ctx context.Context, user *models.User, projectID, taskID, attachmentID int64) error {
+	ctx, span := a.tracer.Start(ctx, "attachmentsUC.Delete")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, a.tasksRepo, projectID, taskID); err != nil {
+		return err
+	}
+	attachment, err := a.attachmentsRepo.GetByID(ctx, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+	if err = checkAuthorOrOwner(ctx, a.projectsRepo, user, projectID, attachment.UploaderID); err != nil {
+		return err
+	}
+	if err = a.attachmentsRepo.Delete(ctx, taskID, attachmentID); err != nil {
+		return err
+	}
+	return a.storage.Delete(ctx, attachment.StorageKey)
+}
+
+// CleanupOrphans removes stored files of deleted attachments, including ones deleted with their tasks and projects.
+// Files failed to be removed are kept queued
+func (a attachmentsUC) CleanupOrphans(ctx context.Context) (int64, error) {
+	ctx, span := a.tracer.Start(ctx, "attachmentsUC.CleanupOrphans")
+	defer span.End()
+
+	var removed int64
+	for {
+		keys, err := a.attachmentsRepo.GetOrphans(ctx, orphansBatchSize)
+		if err != nil || len(keys) == 0 {
+			return removed, err
+		}
+		for i, key := range keys {
+			if err = a.storage.Delete(ctx, key); err != nil {
+				return removed, errors.Join(err, a.attachmentsRepo.DeleteOrphans(ctx, keys[:i]))
+			}
+		}
+		if err = a.attachmentsRepo.DeleteOrphans(ctx, keys); err != nil {
+			return removed, err
+		}
+		removed += int64(len(keys))
+		if len(keys) < orphansBatchSize {
+			return removed, nil
+		}
+	}
+}
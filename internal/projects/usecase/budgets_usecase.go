@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+)
+
+type budgetsUC struct {
+	budgetsRepo projects.BudgetsRepository
+	tracer      trace.Tracer
+}
+
+func NewBudgetsUseCase(budgetsRepo projects.BudgetsRepository) projects.BudgetsUseCase {
+	return budgetsUC{
+		budgetsRepo: budgetsRepo,
+		tracer:      otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (b budgetsUC) GetByProject(ctx context.Context, projectID int64) (*models.ProjectBudget, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsUC.GetByProject")
+	defer span.End()
+
+	return b.budgetsRepo.GetByProject(ctx, projectID)
+}
+
+func (b budgetsUC) Set(ctx context.Context, budget *models.ProjectBudget) (*models.ProjectBudget, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsUC.Set")
+	defer span.End()
+
+	if budget.Thresholds == nil {
+		budget.Thresholds = []int64{50, 80, 100}
+	}
+	sort.Slice(budget.Thresholds, func(i, j int) bool { return budget.Thresholds[i] < budget.Thresholds[j] })
+	return b.budgetsRepo.Set(ctx, budget)
+}
+
+func (b budgetsUC) Delete(ctx context.Context, projectID int64) error {
+	ctx, span := b.tracer.Start(ctx, "budgetsUC.Delete")
+	defer span.End()
+
+	return b.budgetsRepo.Delete(ctx, projectID)
+}
+
+func (b budgetsUC) GetConsumption(ctx context.Context, projectID int64) (*models.BudgetConsumption, error) {
+	ctx, span := b.tracer.Start(ctx, "budgetsUC.GetConsumption")
+	defer span.End()
+
+	budget, err := b.budgetsRepo.GetByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return getConsumption(ctx, b.budgetsRepo, budget)
+}
+
+// getConsumption sums the project entries of the current budget period and compares them with the budget
+func getConsumption(ctx context.Context, budgetsRepo projects.BudgetsRepository, budget *models.ProjectBudget) (*models.BudgetConsumption, error) {
+	consumption, err := budgetsRepo.GetConsumption(ctx, budget.ProjectID, budget.PeriodStart(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	consumption.Kind = budget.Kind
+	consumption.Period = budget.Period
+	consumption.Budget = budget.Amount
+	if budget.Kind == models.BudgetKindHours {
+		consumption.Consumed = math.Round(float64(consumption.TrackedSeconds)/36) / 100
+	} else {
+		consumption.Consumed = consumption.Amount
+	}
+	consumption.Remaining = math.Max(math.Round((budget.Amount-consumption.Consumed)*100)/100, 0)
+	consumption.Percent = math.Round(consumption.Consumed/budget.Amount*10000) / 100
+	consumption.Exceeded = consumption.Consumed >= budget.Amount
+	return consumption, nil
+}
+
+// checkBudget refuses starting timers of the task by non-owners when blocking budget of the project is exceeded
+func checkBudget(ctx context.Context, budgetsRepo projects.BudgetsRepository, projectsRepo projects.Repository,
+	taskID, userID int64) error {
+	budget, err := budgetsRepo.GetByTask(ctx, taskID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !budget.BlockStart {
+		return nil
+	}
+
+	consumption, err := getConsumption(ctx, budgetsRepo, budget)
+	if err != nil {
+		return err
+	}
+	if !consumption.Exceeded {
+		return nil
+	}
+	err = projectsRepo.IsOwner(ctx, budget.ProjectID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return httpErrors.NewRestError(http.StatusForbidden, httpErrors.BudgetExceeded.Error(), consumption)
+	}
+	return err
+}
+
+// notifyBudgetThresholds notifies the project owner about thresholds of the budget crossed by the task entries.
+// Every threshold is notified once per budget period
+func notifyBudgetThresholds(ctx context.Context, budgetsRepo projects.BudgetsRepository, projectsRepo projects.Repository,
+	notificationsRepo projects.NotificationsRepository, taskID int64) error {
+	budget, err := budgetsRepo.GetByTask(ctx, taskID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	consumption, err := getConsumption(ctx, budgetsRepo, budget)
+	if err != nil {
+		return err
+	}
+	crossed := make([]int64, 0, len(budget.Thresholds))
+	for _, threshold := range budget.Thresholds {
+		if consumption.Percent >= float64(threshold) {
+			crossed = append(crossed, threshold)
+		}
+	}
+	if len(crossed) == 0 {
+		return nil
+	}
+
+	created, err := budgetsRepo.CreateAlerts(ctx, budget.ProjectID, consumption.PeriodStart, crossed)
+	if err != nil || len(created) == 0 {
+		return err
+	}
+	project, err := projectsRepo.GetByID(ctx, budget.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	// The highest threshold is notified only, lower ones are implied
+	threshold := created[0]
+	for _, created := range created[1:] {
+		threshold = max(threshold, created)
+	}
+	payload, err := json.Marshal(struct {
+		Threshold int64 `json:"threshold"`
+		*models.BudgetConsumption
+	}{threshold, consumption})
+	if err != nil {
+		return err
+	}
+	_, err = notificationsRepo.Create(ctx, &models.Notification{
+		UserID:    project.CreatorID,
+		Type:      models.NotificationBudgetThreshold,
+		ProjectID: &project.ID,
+		TaskID:    &taskID,
+		Message: fmt.Sprintf("Project %q has consumed %.2f%% of its %s budget", project.Name, consumption.Percent,
+			budget.Period),
+		Payload: payload,
+	})
+	return err
+}
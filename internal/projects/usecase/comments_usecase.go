@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+var (
+	// mentionRegexp matches @<user_id> not preceded by a word character, so emails aren't mentions
+	mentionRegexp = regexp.MustCompile(`(?:^|[^\w@])@(\d+)\b`)
+	// markdownCodeRegexp matches fenced and inline code, mentions inside it are ignored
+	markdownCodeRegexp = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+type commentsUC struct {
+	commentsRepo      projects.CommentsRepository
+	tasksRepo         projects.TasksRepository
+	projectsRepo      projects.Repository
+	notificationsRepo projects.NotificationsRepository
+	tracer            trace.Tracer
+}
+
+func NewCommentsUseCase(commentsRepo projects.CommentsRepository, tasksRepo projects.TasksRepository,
+	projectsRepo projects.Repository, notificationsRepo projects.NotificationsRepository) projects.CommentsUseCase {
+	return commentsUC{
+		commentsRepo:      commentsRepo,
+		tasksRepo:         tasksRepo,
+		projectsRepo:      projectsRepo,
+		notificationsRepo: notificationsRepo,
+		tracer:            otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (c commentsUC) Get(ctx context.Context, taskID int64, query *utils.CommentsQuery) ([]*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsUC.Get")
+	defer span.End()
+
+	if query.Limit == 0 {
+		query.Limit = 100
+	}
+	return c.commentsRepo.Get(ctx, taskID, query)
+}
+
+// Create comments the task and notifies mentioned project members
+func (c commentsUC) Create(ctx context.Context, projectID int64, comment *models.TaskComment) (*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsUC.Create")
+	defer span.End()
+
+	task, err := getProjectTask(ctx, c.tasksRepo, projectID, comment.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Mentions, err = c.resolveMentions(ctx, projectID, comment.Body); err != nil {
+		return nil, err
+	}
+
+	comment, err = c.commentsRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	// The comment is already saved, failed notifications are only traced
+	if err = c.notifyMentioned(ctx, task, comment, comment.Mentions); err != nil {
+		span.RecordError(err)
+	}
+	return comment, nil
+}
+
+// Update edits the comment, only its author can do it. Members mentioned for the first time are notified
+func (c commentsUC) Update(ctx context.Context, user *models.User, projectID int64, updates *models.TaskComment) (*models.TaskComment, error) {
+	ctx, span := c.tracer.Start(ctx, "commentsUC.Update")
+	defer span.End()
+
+	task, err := getProjectTask(ctx, c.tasksRepo, projectID, updates.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := c.commentsRepo.GetByID(ctx, updates.TaskID, updates.ID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != user.ID {
+		return nil, httpErrors.NewForbiddenError("only the author can edit the comment")
+	}
+
+	mentioned := comment.Mentions
+	comment.Body = updates.Body
+	if comment.Mentions, err = c.resolveMentions(ctx, projectID, comment.Body); err != nil {
+		return nil, err
+	}
+	if comment, err = c.commentsRepo.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	added := make([]int64, 0, len(comment.Mentions))
+	for _, userID := range comment.Mentions {
+		if !slices.Contains(mentioned, userID) {
+			added = append(added, userID)
+		}
+	}
+	// The comment is already saved, failed notifications are only traced
+	if err = c.notifyMentioned(ctx, task, comment, added); err != nil {
+		span.RecordError(err)
+	}
+	return comment, nil
+}
+
+// Delete removes the comment, it's allowed to its author, project owner and admins
+func (c commentsUC) Delete(ctx context.Context, user *models.User, projectID, taskID, commentID int64) error {
+	ctx, span := c.tracer.Start(ctx, "commentsUC.Delete")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, c.tasksRepo, projectID, taskID); err != nil {
+		return err
+	}
+	comment, err := c.commentsRepo.GetByID(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+	if err = checkAuthorOrOwner(ctx, c.projectsRepo, user, projectID, comment.AuthorID); err != nil {
+		return err
+	}
+	return c.commentsRepo.Delete(ctx, taskID, commentID)
+}
+
+// resolveMentions returns ids of project members and the owner mentioned in the body, unknown users are left as plain text
+func (c commentsUC) resolveMentions(ctx context.Context, projectID int64, body string) (pq.Int64Array, error) {
+	mentions := pq.Int64Array{}
+	for _, match := range mentionRegexp.FindAllStringSubmatch(markdownCodeRegexp.ReplaceAllString(body, ""), -1) {
+		userID, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || slices.Contains(mentions, userID) {
+			continue
+		}
+		// The owner isn't a participant of the project, but sees all its tasks
+		err = c.projectsRepo.IsMember(ctx, projectID, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = c.projectsRepo.IsOwner(ctx, projectID, userID)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, userID)
+	}
+	return mentions, nil
+}
+
+// notifyMentioned notifies mentioned users except the author
+func (c commentsUC) notifyMentioned(ctx context.Context, task *models.Task, comment *models.TaskComment, userIDs []int64) error {
+	payload, err := json.Marshal(struct {
+		CommentID int64 `json:"comment_id"`
+		AuthorID  int64 `json:"author_id"`
+	}{comment.ID, comment.AuthorID})
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if userID == comment.AuthorID {
+			continue
+		}
+		if _, err = c.notificationsRepo.Create(ctx, &models.Notification{
+			UserID:    userID,
+			Type:      models.NotificationCommentMention,
+			ProjectID: &task.ProjectID,
+			TaskID:    &task.ID,
+			Message:   fmt.Sprintf("You were mentioned in a comment on task %q", task.Name),
+			Payload:   payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
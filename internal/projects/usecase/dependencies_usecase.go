@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type dependenciesUC struct {
+	dependenciesRepo projects.DependenciesRepository
+	tasksRepo        projects.TasksRepository
+	tasksRedisRepo   projects.TasksRedisRepository
+	tracer           trace.Tracer
+}
+
+func NewDependenciesUseCase(dependenciesRepo projects.DependenciesRepository, tasksRepo projects.TasksRepository,
+	tasksRedisRepo projects.TasksRedisRepository) projects.DependenciesUseCase {
+	return dependenciesUC{
+		dependenciesRepo: dependenciesRepo,
+		tasksRepo:        tasksRepo,
+		tasksRedisRepo:   tasksRedisRepo,
+		tracer:           otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (d dependenciesUC) Get(ctx context.Context, taskID int64) ([]*models.DependencyTask, error) {
+	ctx, span := d.tracer.Start(ctx, "dependenciesUC.Get")
+	defer span.End()
+
+	return d.dependenciesRepo.Get(ctx, taskID)
+}
+
+// Create blocks the task by another task of the same project
+func (d dependenciesUC) Create(ctx context.Context, projectID int64, dependency *models.TaskDependency) error {
+	ctx, span := d.tracer.Start(ctx, "dependenciesUC.Create")
+	defer span.End()
+
+	if dependency.TaskID == dependency.BlockerID {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskDependency.Error(),
+			"task can't block itself")
+	}
+	for _, taskID := range []int64{dependency.TaskID, dependency.BlockerID} {
+		task, err := d.tasksRepo.GetByID(ctx, taskID)
+		if errors.Is(err, sql.ErrNoRows) || err == nil && task.ProjectID != projectID {
+			return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskDependency.Error(),
+				"task isn't found in the project")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := d.dependenciesRepo.Create(ctx, projectID, dependency); err != nil {
+		return err
+	}
+	return d.tasksRedisRepo.DeleteTask(ctx, dependency.TaskID)
+}
+
+func (d dependenciesUC) Delete(ctx context.Context, taskID, blockerID int64) error {
+	ctx, span := d.tracer.Start(ctx, "dependenciesUC.Delete")
+	defer span.End()
+
+	if err := d.dependenciesRepo.Delete(ctx, taskID, blockerID); err != nil {
+		return err
+	}
+	return d.tasksRedisRepo.DeleteTask(ctx, taskID)
+}
+
+func (d dependenciesUC) GetGraph(ctx context.Context, projectID int64) (*models.DependencyGraph, error) {
+	ctx, span := d.tracer.Start(ctx, "dependenciesUC.GetGraph")
+	defer span.End()
+
+	return d.dependenciesRepo.GetGraph(ctx, projectID)
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/pdf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// invoiceStatusTransitions maps the new status of the invoice to the previous one
+var invoiceStatusTransitions = map[string]string{
+	models.InvoiceStatusSent: models.InvoiceStatusDraft,
+	models.InvoiceStatusPaid: models.InvoiceStatusSent,
+}
+
+type invoicesUC struct {
+	invoicesRepo projects.InvoicesRepository
+	projectsRepo projects.Repository
+	tracer       trace.Tracer
+}
+
+func NewInvoicesUseCase(invoicesRepo projects.InvoicesRepository, projectsRepo projects.Repository) projects.InvoicesUseCase {
+	return invoicesUC{
+		invoicesRepo: invoicesRepo,
+		projectsRepo: projectsRepo,
+		tracer:       otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (i invoicesUC) Get(ctx context.Context, projectID int64) ([]*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.Get")
+	defer span.End()
+
+	return i.invoicesRepo.Get(ctx, projectID)
+}
+
+func (i invoicesUC) GetByID(ctx context.Context, projectID, invoiceID int64) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.GetByID")
+	defer span.End()
+
+	return i.invoicesRepo.GetByID(ctx, projectID, invoiceID)
+}
+
+func (i invoicesUC) Create(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.Create")
+	defer span.End()
+
+	if invoice.PeriodTo.Before(invoice.PeriodFrom) {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "to is before from")
+	}
+	if invoice.GroupBy == "" {
+		invoice.GroupBy = models.InvoiceGroupByTask
+	}
+	return i.invoicesRepo.Create(ctx, invoice)
+}
+
+func (i invoicesUC) UpdateStatus(ctx context.Context, projectID, invoiceID int64, status string) (*models.Invoice, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.UpdateStatus")
+	defer span.End()
+
+	invoice, err := i.invoicesRepo.GetByID(ctx, projectID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	prevStatus, ok := invoiceStatusTransitions[status]
+	if !ok || invoice.Status != prevStatus {
+		return nil, httpErrors.NewRestError(http.StatusConflict, httpErrors.InvalidInvoiceStatus.Error(),
+			fmt.Sprintf("%s -> %s", invoice.Status, status))
+	}
+
+	invoice.Status = status
+	lines := invoice.Lines
+	if invoice, err = i.invoicesRepo.UpdateStatus(ctx, invoice, prevStatus); err != nil {
+		return nil, err
+	}
+	invoice.Lines = lines
+	return invoice, nil
+}
+
+// Delete deletes draft invoice and releases its entries
+func (i invoicesUC) Delete(ctx context.Context, projectID, invoiceID int64) error {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.Delete")
+	defer span.End()
+
+	invoice, err := i.invoicesRepo.GetByID(ctx, projectID, invoiceID)
+	if err != nil {
+		return err
+	}
+	if invoice.Status != models.InvoiceStatusDraft {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.InvalidInvoiceStatus.Error(),
+			"only draft invoices can be deleted")
+	}
+	return i.invoicesRepo.Delete(ctx, projectID, invoiceID)
+}
+
+func (i invoicesUC) GetPDF(ctx context.Context, projectID, invoiceID int64) ([]byte, error) {
+	ctx, span := i.tracer.Start(ctx, "invoicesUC.GetPDF")
+	defer span.End()
+
+	invoice, err := i.invoicesRepo.GetByID(ctx, projectID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := i.projectsRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if _, err = renderInvoice(invoice, project).WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func renderInvoice(invoice *models.Invoice, project *models.Project) *pdf.Document {
+	const (
+		size       = 10
+		hoursX     = 360
+		amountX    = pdf.PageWidth - 2*pdf.Margin
+		dateLayout = "2006-01-02"
+	)
+
+	doc := pdf.New()
+	doc.Line(18, true, fmt.Sprintf("Invoice #%d", invoice.Number))
+	doc.Space(size)
+	doc.Line(size, false, "Project: "+project.Name)
+	doc.Line(size, false, fmt.Sprintf("Period: %s - %s", invoice.PeriodFrom.Format(dateLayout),
+		invoice.PeriodTo.Format(dateLayout)))
+	doc.Line(size, false, "Issued: "+invoice.CreatedAt.Format(dateLayout))
+	doc.Line(size, false, "Status: "+invoice.Status)
+	doc.Space(size)
+
+	description := "Task"
+	if invoice.GroupBy == models.InvoiceGroupByMember {
+		description = "Member"
+	}
+	doc.Row(size, true,
+		pdf.Cell{Text: description},
+		pdf.Cell{X: hoursX, Text: "Hours", AlignRight: true},
+		pdf.Cell{X: amountX, Text: "Amount", AlignRight: true},
+	)
+	for _, line := range invoice.Lines {
+		doc.Row(size, false,
+			pdf.Cell{Text: line.Description},
+			pdf.Cell{X: hoursX, Text: fmt.Sprintf("%d:%02d", line.Seconds/3600, line.Seconds%3600/60), AlignRight: true},
+			pdf.Cell{X: amountX, Text: fmt.Sprintf("%.2f", line.Amount), AlignRight: true},
+		)
+	}
+	doc.Space(size)
+
+	doc.Row(size, false, pdf.Cell{X: hoursX, Text: "Subtotal", AlignRight: true},
+		pdf.Cell{X: amountX, Text: fmt.Sprintf("%.2f", invoice.Subtotal), AlignRight: true})
+	doc.Row(size, false, pdf.Cell{X: hoursX, Text: fmt.Sprintf("Tax %.2f%%", invoice.TaxRate), AlignRight: true},
+		pdf.Cell{X: amountX, Text: fmt.Sprintf("%.2f", invoice.Tax), AlignRight: true})
+	doc.Row(size, true, pdf.Cell{X: hoursX, Text: "Total", AlignRight: true},
+		pdf.Cell{X: amountX, Text: fmt.Sprintf("%.2f", invoice.Total), AlignRight: true})
+	return doc
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type labelsUC struct {
+	labelsRepo     projects.LabelsRepository
+	tasksRepo      projects.TasksRepository
+	tasksRedisRepo projects.TasksRedisRepository
+	tracer         trace.Tracer
+}
+
+func NewLabelsUseCase(labelsRepo projects.LabelsRepository, tasksRepo projects.TasksRepository,
+	tasksRedisRepo projects.TasksRedisRepository) projects.LabelsUseCase {
+	return labelsUC{
+		labelsRepo:     labelsRepo,
+		tasksRepo:      tasksRepo,
+		tasksRedisRepo: tasksRedisRepo,
+		tracer:         otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (l labelsUC) Get(ctx context.Context, projectID int64) ([]*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Get")
+	defer span.End()
+
+	return l.labelsRepo.Get(ctx, projectID)
+}
+
+func (l labelsUC) Create(ctx context.Context, label *models.Label) (*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Create")
+	defer span.End()
+
+	if label.Color == "" {
+		label.Color = models.DefaultLabelColor
+	}
+	return l.labelsRepo.Create(ctx, label)
+}
+
+func (l labelsUC) Update(ctx context.Context, label *models.Label) (*models.Label, error) {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Update")
+	defer span.End()
+
+	return l.labelsRepo.Update(ctx, label)
+}
+
+func (l labelsUC) Delete(ctx context.Context, projectID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Delete")
+	defer span.End()
+
+	return l.labelsRepo.Delete(ctx, projectID, labelID)
+}
+
+// Attach labels the task with the label of its project
+func (l labelsUC) Attach(ctx context.Context, projectID, taskID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Attach")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, l.tasksRepo, projectID, taskID); err != nil {
+		return err
+	}
+	if err := l.labelsRepo.Attach(ctx, taskID, labelID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, l.tasksRepo, l.tasksRedisRepo, taskID)
+}
+
+func (l labelsUC) Detach(ctx context.Context, projectID, taskID, labelID int64) error {
+	ctx, span := l.tracer.Start(ctx, "labelsUC.Detach")
+	defer span.End()
+
+	if _, err := getProjectTask(ctx, l.tasksRepo, projectID, taskID); err != nil {
+		return err
+	}
+	if err := l.labelsRepo.Detach(ctx, taskID, labelID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, l.tasksRepo, l.tasksRedisRepo, taskID)
+}
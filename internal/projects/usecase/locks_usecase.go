@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type locksUC struct {
+	locksRepo projects.LocksRepository
+	tracer    trace.Tracer
+}
+
+func NewLocksUseCase(locksRepo projects.LocksRepository) projects.LocksUseCase {
+	return locksUC{
+		locksRepo: locksRepo,
+		tracer:    otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (l locksUC) Get(ctx context.Context) ([]*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksUC.Get")
+	defer span.End()
+
+	return l.locksRepo.Get(ctx)
+}
+
+func (l locksUC) GetByProject(ctx context.Context, projectID int64) (*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksUC.GetByProject")
+	defer span.End()
+
+	return l.locksRepo.GetByProject(ctx, projectID)
+}
+
+func (l locksUC) Set(ctx context.Context, lock *models.PeriodLock) (*models.PeriodLock, error) {
+	ctx, span := l.tracer.Start(ctx, "locksUC.Set")
+	defer span.End()
+
+	return l.locksRepo.Set(ctx, lock)
+}
+
+func (l locksUC) Delete(ctx context.Context, projectID int64) error {
+	ctx, span := l.tracer.Start(ctx, "locksUC.Delete")
+	defer span.End()
+
+	return l.locksRepo.Delete(ctx, projectID)
+}
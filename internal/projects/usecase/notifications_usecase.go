@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type notificationsUC struct {
+	notificationsRepo projects.NotificationsRepository
+	tracer            trace.Tracer
+}
+
+func NewNotificationsUseCase(notificationsRepo projects.NotificationsRepository) projects.NotificationsUseCase {
+	return notificationsUC{
+		notificationsRepo: notificationsRepo,
+		tracer:            otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (n notificationsUC) Get(ctx context.Context, userID int64, query *utils.NotificationsQuery) ([]*models.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsUC.Get")
+	defer span.End()
+
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+	return n.notificationsRepo.Get(ctx, userID, query)
+}
+
+func (n notificationsUC) Read(ctx context.Context, userID, notificationID int64) (*models.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsUC.Read")
+	defer span.End()
+
+	return n.notificationsRepo.Read(ctx, userID, notificationID)
+}
+
+func (n notificationsUC) ReadAll(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := n.tracer.Start(ctx, "notificationsUC.ReadAll")
+	defer span.End()
+
+	return n.notificationsRepo.ReadAll(ctx, userID)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ratesUC struct {
+	ratesRepo    projects.RatesRepository
+	projectsRepo projects.Repository
+	tracer       trace.Tracer
+}
+
+func NewRatesUseCase(ratesRepo projects.RatesRepository, projectsRepo projects.Repository) projects.RatesUseCase {
+	return ratesUC{
+		ratesRepo:    ratesRepo,
+		projectsRepo: projectsRepo,
+		tracer:       otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (r ratesUC) GetUserRates(ctx context.Context, userID int64) ([]*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesUC.GetUserRates")
+	defer span.End()
+
+	return r.ratesRepo.GetUserRates(ctx, userID)
+}
+
+func (r ratesUC) GetProjectRates(ctx context.Context, projectID int64) ([]*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesUC.GetProjectRates")
+	defer span.End()
+
+	return r.ratesRepo.GetProjectRates(ctx, projectID)
+}
+
+func (r ratesUC) Create(ctx context.Context, rate *models.HourlyRate) (*models.HourlyRate, error) {
+	ctx, span := r.tracer.Start(ctx, "ratesUC.Create")
+	defer span.End()
+
+	// Rate of the project member is set for members only
+	if rate.ProjectID != nil && rate.UserID != nil {
+		if err := r.projectsRepo.IsMember(ctx, *rate.ProjectID, *rate.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return r.ratesRepo.Create(ctx, rate)
+}
+
+func (r ratesUC) Delete(ctx context.Context, projectID, rateID int64) error {
+	ctx, span := r.tracer.Start(ctx, "ratesUC.Delete")
+	defer span.End()
+
+	return r.ratesRepo.Delete(ctx, projectID, rateID)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type reportsUC struct {
+	reportsRepo projects.ReportsRepository
+	tracer      trace.Tracer
+}
+
+func NewReportsUseCase(reportsRepo projects.ReportsRepository) projects.ReportsUseCase {
+	return reportsUC{
+		reportsRepo: reportsRepo,
+		tracer:      otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (r reportsUC) Get(ctx context.Context, user *models.User, query *utils.ReportQuery) (*models.Report, error) {
+	ctx, span := r.tracer.Start(ctx, "reportsUC.Get")
+	defer span.End()
+
+	if err := validateReportQuery(query); err != nil {
+		return nil, err
+	}
+	rows, err := r.reportsRepo.Get(ctx, user.ID, user.Admin, query)
+	if err != nil {
+		return nil, err
+	}
+	return buildReport(query, rows), nil
+}
+
+// Export passes flat rows of the report to fn without subtotals
+func (r reportsUC) Export(ctx context.Context, user *models.User, query *utils.ReportQuery,
+	fn func(row *models.ReportRow) error) error {
+	ctx, span := r.tracer.Start(ctx, "reportsUC.Export")
+	defer span.End()
+
+	if err := validateReportQuery(query); err != nil {
+		return err
+	}
+	return r.reportsRepo.Stream(ctx, user.ID, user.Admin, query, fn)
+}
+
+func (r reportsUC) GetEntries(ctx context.Context, user *models.User, filter *utils.ReportFilter) ([]*models.ReportEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "reportsUC.GetEntries")
+	defer span.End()
+
+	if err := validateReportFilter(filter); err != nil {
+		return nil, err
+	}
+	return r.reportsRepo.GetEntries(ctx, user.ID, user.Admin, filter)
+}
+
+func (r reportsUC) ExportEntries(ctx context.Context, user *models.User, filter *utils.ReportFilter,
+	fn func(entry *models.ReportEntry) error) error {
+	ctx, span := r.tracer.Start(ctx, "reportsUC.ExportEntries")
+	defer span.End()
+
+	if err := validateReportFilter(filter); err != nil {
+		return err
+	}
+	return r.reportsRepo.StreamEntries(ctx, user.ID, user.Admin, filter, fn)
+}
+
+func validateReportQuery(query *utils.ReportQuery) error {
+	if len(query.GroupBy) > 1 && query.GroupBy[0] == query.GroupBy[1] {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.BadQueryParams.Error(), "group_by is repeated")
+	}
+	return validateReportFilter(&query.ReportFilter)
+}
+
+// validateReportFilter checks the date range and sets default timezone
+func validateReportFilter(filter *utils.ReportFilter) error {
+	// Dates are validated by ReadRequest and compared as YYYY-MM-DD strings
+	if filter.To < filter.From {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "to is before from")
+	}
+	if filter.Timezone == "" {
+		filter.Timezone = "UTC"
+	}
+	return nil
+}
+
+// buildReport nests rows of the second dimension into groups of the first one and sums subtotals.
+// Rows are ordered by the first dimension, so its groups are contiguous
+func buildReport(query *utils.ReportQuery, rows []*models.ReportRow) *models.Report {
+	report := &models.Report{
+		From:    query.From,
+		To:      query.To,
+		GroupBy: query.GroupBy,
+		Groups:  make([]*models.ReportGroup, 0, len(rows)),
+	}
+
+	var group *models.ReportGroup
+	for _, row := range rows {
+		if group == nil || group.Key != row.Key1 {
+			group = &models.ReportGroup{Key: row.Key1, Name: row.Name1}
+			report.Groups = append(report.Groups, group)
+		}
+		group.TotalSeconds += row.TotalSeconds
+		group.BillableSeconds += row.BillableSeconds
+		report.TotalSeconds += row.TotalSeconds
+		report.BillableSeconds += row.BillableSeconds
+
+		if row.Key2 != nil {
+			group.Groups = append(group.Groups, &models.ReportGroup{
+				Key:             *row.Key2,
+				Name:            *row.Name2,
+				TotalSeconds:    row.TotalSeconds,
+				BillableSeconds: row.BillableSeconds,
+			})
+		}
+	}
+	return report
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type statusesUC struct {
+	statusesRepo projects.StatusesRepository
+	tracer       trace.Tracer
+}
+
+func NewStatusesUseCase(statusesRepo projects.StatusesRepository) projects.StatusesUseCase {
+	return statusesUC{
+		statusesRepo: statusesRepo,
+		tracer:       otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (s statusesUC) Get(ctx context.Context, projectID int64) ([]*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesUC.Get")
+	defer span.End()
+
+	return s.statusesRepo.Get(ctx, projectID)
+}
+
+func (s statusesUC) Create(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesUC.Create")
+	defer span.End()
+
+	return s.statusesRepo.Create(ctx, status, position)
+}
+
+func (s statusesUC) Update(ctx context.Context, status *models.TaskStatus, position *int) (*models.TaskStatus, error) {
+	ctx, span := s.tracer.Start(ctx, "statusesUC.Update")
+	defer span.End()
+
+	return s.statusesRepo.Update(ctx, status, position)
+}
+
+// Delete deletes unused status, the project keeps at least one status for new tasks
+func (s statusesUC) Delete(ctx context.Context, projectID, statusID int64) error {
+	ctx, span := s.tracer.Start(ctx, "statusesUC.Delete")
+	defer span.End()
+
+	statuses, err := s.statusesRepo.Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if len(statuses) == 1 && statuses[0].ID == statusID {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.LastTaskStatus.Error(), nil)
+	}
+	return s.statusesRepo.Delete(ctx, projectID, statusID)
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tagsUC struct {
+	tagsRepo projects.TagsRepository
+	tracer   trace.Tracer
+}
+
+func NewTagsUseCase(tagsRepo projects.TagsRepository) projects.TagsUseCase {
+	return tagsUC{
+		tagsRepo: tagsRepo,
+		tracer:   otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (t tagsUC) Get(ctx context.Context, projectID int64) ([]*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsUC.Get")
+	defer span.End()
+
+	return t.tagsRepo.Get(ctx, projectID)
+}
+
+func (t tagsUC) Create(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsUC.Create")
+	defer span.End()
+
+	return t.tagsRepo.Create(ctx, tag)
+}
+
+func (t tagsUC) Update(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	ctx, span := t.tracer.Start(ctx, "tagsUC.Update")
+	defer span.End()
+
+	return t.tagsRepo.Update(ctx, tag)
+}
+
+func (t tagsUC) Delete(ctx context.Context, projectID, tagID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tagsUC.Delete")
+	defer span.End()
+
+	return t.tagsRepo.Delete(ctx, projectID, tagID)
+}
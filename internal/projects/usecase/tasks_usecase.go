@@ -2,64 +2,733 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/armanokka/time_tracker/config"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"time"
 )
 
+// taskCacheTimeSeconds is short, because cached task detail includes time of running entries
+const taskCacheTimeSeconds = 60
+
 type tasksUC struct {
-	tasksRepo projects.TasksRepository
-	tracer    trace.Tracer
+	cfg               config.TimerConfig
+	tasksCfg          config.TasksConfig
+	tasksRepo         projects.TasksRepository
+	tasksRedisRepo    projects.TasksRedisRepository
+	entriesRepo       projects.TimeEntriesRepository
+	projectsRepo      projects.Repository
+	budgetsRepo       projects.BudgetsRepository
+	notificationsRepo projects.NotificationsRepository
+	statusesRepo      projects.StatusesRepository
+	dependenciesRepo  projects.DependenciesRepository
+	tracer            trace.Tracer
 }
 
-func NewTasksUseCase(tasksRepo projects.TasksRepository) projects.TasksUseCase {
+func NewTasksUseCase(cfg config.TimerConfig, tasksCfg config.TasksConfig, tasksRepo projects.TasksRepository,
+	tasksRedisRepo projects.TasksRedisRepository, entriesRepo projects.TimeEntriesRepository,
+	projectsRepo projects.Repository, budgetsRepo projects.BudgetsRepository,
+	notificationsRepo projects.NotificationsRepository, statusesRepo projects.StatusesRepository,
+	dependenciesRepo projects.DependenciesRepository) projects.TasksUseCase {
 	return tasksUC{
-		tasksRepo: tasksRepo,
-		tracer:    otel.GetTracerProvider().Tracer("api"),
+		cfg:               cfg,
+		tasksCfg:          tasksCfg,
+		tasksRepo:         tasksRepo,
+		tasksRedisRepo:    tasksRedisRepo,
+		entriesRepo:       entriesRepo,
+		projectsRepo:      projectsRepo,
+		budgetsRepo:       budgetsRepo,
+		notificationsRepo: notificationsRepo,
+		statusesRepo:      statusesRepo,
+		dependenciesRepo:  dependenciesRepo,
+		tracer:            otel.GetTracerProvider().Tracer("api"),
 	}
 }
 
-func (t tasksUC) Get(ctx context.Context, taskID int64) ([]*models.Task, error) {
+func (t tasksUC) Get(ctx context.Context, projectID int64, query *utils.TasksQuery) (utils.TasksQueryResponse, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Get")
 	defer span.End()
 
-	return t.tasksRepo.Get(ctx, taskID)
+	return t.tasksRepo.Get(ctx, projectID, query)
+}
+
+// GetByID returns the task with its members, subtasks, blockers, labels, tracked time and running entries.
+// Time tracked in subtasks rolls up to the task
+func (t tasksUC) GetByID(ctx context.Context, projectID, taskID int64) (*models.TaskDetail, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.GetByID")
+	defer span.End()
+
+	task, err := t.tasksRedisRepo.GetTask(ctx, taskID)
+	if err == nil {
+		if task.ProjectID != projectID {
+			return nil, sql.ErrNoRows
+		}
+		return task, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	task = &models.TaskDetail{CalculatedAt: time.Now().UTC()}
+	current, err := t.tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if current.ProjectID != projectID {
+		return nil, sql.ErrNoRows
+	}
+	task.Task = *current
+	if task.Members, err = t.tasksRepo.GetMembers(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if task.Subtasks, err = t.tasksRepo.GetSubtasks(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if task.BlockedBy, err = t.dependenciesRepo.Get(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if task.Labels, err = t.tasksRepo.GetLabels(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if task.MembersTime, err = t.tasksRepo.GetMembersTime(ctx, taskID); err != nil {
+		return nil, err
+	}
+	for _, memberTime := range task.MembersTime {
+		task.TotalSeconds += memberTime.TotalSeconds
+		task.BillableSeconds += memberTime.BillableSeconds
+		task.OwnSeconds += memberTime.OwnSeconds
+	}
+	if task.RunningEntries, err = t.tasksRepo.GetRunningEntries(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	if err = t.tasksRedisRepo.SetTask(ctx, task, taskCacheTimeSeconds); err != nil {
+		return nil, err
+	}
+	return task, nil
 }
 
 func (t tasksUC) Create(ctx context.Context, task *models.Task) (*models.Task, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Create")
 	defer span.End()
 
-	return t.tasksRepo.Create(ctx, task)
+	if err := t.checkCreate(ctx, task); err != nil {
+		return nil, err
+	}
+	createdTask, err := t.tasksRepo.Create(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if task.ParentID == nil || *task.ParentID == 0 {
+		return createdTask, nil
+	}
+	// Detail of the parent lists its subtasks
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, *task.ParentID); err != nil {
+		return nil, err
+	}
+	return createdTask, nil
+}
+
+// checkCreate checks the new task and sets its defaults
+func (t tasksUC) checkCreate(ctx context.Context, task *models.Task) error {
+	if err := checkDates(task); err != nil {
+		return err
+	}
+	if task.Priority == 0 {
+		task.Priority = models.TaskPriorityMedium
+	}
+	if task.StatusID != 0 {
+		if _, err := t.statusesRepo.GetByID(ctx, task.ProjectID, task.StatusID); err != nil {
+			return err
+		}
+	}
+	if task.ParentID == nil || *task.ParentID == 0 {
+		return nil
+	}
+	return t.checkParent(ctx, task, *task.ParentID)
 }
 
 func (t tasksUC) Update(ctx context.Context, task *models.Task) (*models.Task, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Update")
 	defer span.End()
 
-	return t.tasksRepo.Update(ctx, task)
+	status, err := t.checkUpdate(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return t.update(ctx, task)
+	}
+
+	// Moved subtask changes tracked time of its former ancestors too
+	if task.ParentID != nil {
+		if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+			return nil, err
+		}
+	}
+	done := status.Category == models.TaskStatusDone
+	if task, err = t.tasksRepo.SetStatus(ctx, task, done); err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+		return nil, err
+	}
+	if done {
+		// Entries are already stopped, failed notification is only traced
+		if err = notifyBudgetThresholds(ctx, t.budgetsRepo, t.projectsRepo, t.notificationsRepo, task.ID); err != nil {
+			span.RecordError(err)
+		}
+	}
+	return task, nil
+}
+
+// checkUpdate checks the changes of the task. It returns the new status of the task, nil if the status isn't changed
+func (t tasksUC) checkUpdate(ctx context.Context, task *models.Task) (*models.TaskStatus, error) {
+	if err := checkDates(task); err != nil {
+		return nil, err
+	}
+	moved := task.ParentID != nil && *task.ParentID != 0
+	dated := task.StartAt != nil || task.DueAt != nil
+	if task.StatusID == 0 && !moved && !dated {
+		return nil, nil
+	}
+
+	current, err := t.tasksRepo.GetByID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The date being set is compared with the stored one, unless it's changed too
+	if dated {
+		if err = checkDates(&models.Task{
+			StartAt: updatedDate(current.StartAt, task.StartAt, task.ClearStartAt),
+			DueAt:   updatedDate(current.DueAt, task.DueAt, task.ClearDueAt),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if moved && (current.ParentID == nil || *current.ParentID != *task.ParentID) {
+		if err = t.checkParent(ctx, current, *task.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	if task.StatusID == 0 || task.StatusID == current.StatusID {
+		return nil, nil
+	}
+	status, err := t.statusesRepo.GetByID(ctx, current.ProjectID, task.StatusID)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := t.statusesRepo.IsTransitionAllowed(ctx, current.StatusID, status.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, httpErrors.NewRestError(http.StatusConflict, httpErrors.InvalidTaskTransition.Error(), nil)
+	}
+	if status.Category != models.TaskStatusDone {
+		return status, nil
+	}
+
+	// Done task stops its running entries, which can't be done in locked periods and approved weeks
+	running, err := t.tasksRepo.GetRunningEntries(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range running {
+		if err = checkLocked(ctx, t.entriesRepo, entry.TaskID, entry.StartedAt); err != nil {
+			return nil, err
+		}
+		if err = checkApproved(ctx, t.entriesRepo, &entry.TimeEntry); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+func (t tasksUC) Move(ctx context.Context, projectID, taskID, siblingID int64, after bool) (*models.Task, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.Move")
+	defer span.End()
+
+	task, err := getProjectTask(ctx, t.tasksRepo, projectID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	sibling, err := t.tasksRepo.GetByID(ctx, siblingID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && (sibling.ProjectID != projectID || sibling.ID == task.ID) {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskMove.Error(),
+			"sibling task isn't found in the project")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sibling.StatusID != task.StatusID {
+		if _, err = t.Update(ctx, &models.Task{ID: task.ID, StatusID: sibling.StatusID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if task, err = t.tasksRepo.Move(ctx, task.ID, sibling.ID, after); err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// update changes the task without status transition and drops cached detail of the task and its ancestors
+func (t tasksUC) update(ctx context.Context, task *models.Task) (*models.Task, error) {
+	// Moved subtask changes tracked time of its former ancestors too
+	if task.ParentID != nil {
+		if err := dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+			return nil, err
+		}
+	}
+	updatedTask, err := t.tasksRepo.Update(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+		return nil, err
+	}
+	return updatedTask, nil
+}
+
+// checkParent refuses parents from other projects, cycles and nesting deeper than configured
+func (t tasksUC) checkParent(ctx context.Context, task *models.Task, parentID int64) error {
+	parent, err := t.tasksRepo.GetByID(ctx, parentID)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && parent.ProjectID != task.ProjectID {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskParent.Error(),
+			"parent task isn't found in the project")
+	}
+	if err != nil {
+		return err
+	}
+
+	var height int
+	if task.ID != 0 {
+		if parentID == task.ID {
+			return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskParent.Error(), nil)
+		}
+		subtask, err := t.tasksRepo.IsSubtask(ctx, task.ID, parentID)
+		if err != nil {
+			return err
+		}
+		if subtask {
+			return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTaskParent.Error(),
+				"parent task is a subtask of the task")
+		}
+		if height, err = t.tasksRepo.GetHeight(ctx, task.ID); err != nil {
+			return err
+		}
+	}
+	depth, err := t.tasksRepo.GetDepth(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if depth+1+height > t.tasksCfg.MaxDepth {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.TaskDepthExceeded.Error(),
+			fmt.Sprintf("max depth is %d", t.tasksCfg.MaxDepth))
+	}
+	return nil
 }
 
 func (t tasksUC) Delete(ctx context.Context, taskID int64) error {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Delete")
 	defer span.End()
 
-	return t.tasksRepo.Delete(ctx, taskID)
+	// Ancestors are unknown after the task is deleted
+	ancestorIDs, err := t.tasksRepo.GetAncestorIDs(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if err = t.tasksRepo.Delete(ctx, taskID); err != nil {
+		return err
+	}
+	for _, id := range append(ancestorIDs, taskID) {
+		if err = t.tasksRedisRepo.DeleteTask(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bulk validates every item of the batch like the single task endpoints do and applies the batch in one transaction.
+// Nothing is applied if any item fails, the response has the result of every item
+func (t tasksUC) Bulk(ctx context.Context, user *models.User, projectID int64,
+	batch *utils.BulkTasksRequest) (*utils.BulkTasksResponse, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.Bulk")
+	defer span.End()
+
+	response := &utils.BulkTasksResponse{
+		Ok:            true,
+		Create:        make([]*utils.BulkTaskResult, len(batch.Create)),
+		Update:        make([]*utils.BulkTaskResult, len(batch.Update)),
+		Delete:        make([]*utils.BulkTaskResult, len(batch.Delete)),
+		AddMembers:    make([]*utils.BulkTaskResult, len(batch.AddMembers)),
+		DeleteMembers: make([]*utils.BulkTaskResult, len(batch.DeleteMembers)),
+	}
+	results := map[string][]*utils.BulkTaskResult{
+		utils.BulkCreate:        response.Create,
+		utils.BulkUpdate:        response.Update,
+		utils.BulkDelete:        response.Delete,
+		utils.BulkAddMembers:    response.AddMembers,
+		utils.BulkDeleteMembers: response.DeleteMembers,
+	}
+	// setResult fails the item with the client error, other errors fail the whole request
+	setResult := func(op string, i int, err error) error {
+		if err == nil {
+			results[op][i] = &utils.BulkTaskResult{Ok: true}
+			return nil
+		}
+		restErr := httpErrors.ParseErrors(err)
+		if restErr.Status() >= http.StatusInternalServerError {
+			return err
+		}
+		response.Ok = false
+		results[op][i] = &utils.BulkTaskResult{Error: restErr}
+		return nil
+	}
+
+	// Cached details of affected tasks and their ancestors are dropped after commit
+	cacheIDs := make(map[int64]struct{})
+	addCacheIDs := func(taskID int64) error {
+		ancestorIDs, err := t.tasksRepo.GetAncestorIDs(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		for _, id := range append(ancestorIDs, taskID) {
+			cacheIDs[id] = struct{}{}
+		}
+		return nil
+	}
+
+	for i, task := range batch.Create {
+		task.ProjectID = projectID
+		err := utils.ValidateStruct(ctx, task)
+		if err == nil {
+			err = t.checkCreate(ctx, task)
+		}
+		if err == nil && task.ParentID != nil && *task.ParentID != 0 {
+			err = addCacheIDs(*task.ParentID)
+		}
+		if err = setResult(utils.BulkCreate, i, err); err != nil {
+			return nil, err
+		}
+	}
+	doneTaskIDs := make([]int64, 0)
+	for i, task := range batch.Update {
+		err := utils.ValidateStruct(ctx, task)
+		if err == nil && task.ID == 0 {
+			err = httpErrors.NewBadRequestError("task id is required")
+		}
+		if err == nil {
+			_, err = getProjectTask(ctx, t.tasksRepo, projectID, task.ID)
+		}
+		var status *models.TaskStatus
+		if err == nil {
+			status, err = t.checkUpdate(ctx, task)
+		}
+		if err == nil {
+			err = addCacheIDs(task.ID)
+		}
+		if err == nil && status != nil && status.Category == models.TaskStatusDone {
+			doneTaskIDs = append(doneTaskIDs, task.ID)
+		}
+		if err = setResult(utils.BulkUpdate, i, err); err != nil {
+			return nil, err
+		}
+	}
+	for i, taskID := range batch.Delete {
+		_, err := getProjectTask(ctx, t.tasksRepo, projectID, taskID)
+		if err == nil {
+			err = addCacheIDs(taskID)
+		}
+		if err = setResult(utils.BulkDelete, i, err); err != nil {
+			return nil, err
+		}
+	}
+	for op, members := range map[string][]*utils.BulkTaskMember{
+		utils.BulkAddMembers:    batch.AddMembers,
+		utils.BulkDeleteMembers: batch.DeleteMembers,
+	} {
+		for i, member := range members {
+			err := utils.ValidateStruct(ctx, member)
+			if err == nil {
+				// Members are managed by project owner and admins only
+				err = checkAuthorOrOwner(ctx, t.projectsRepo, user, projectID, 0)
+			}
+			if err == nil {
+				_, err = getProjectTask(ctx, t.tasksRepo, projectID, member.TaskID)
+			}
+			if err == nil {
+				err = addCacheIDs(member.TaskID)
+			}
+			if err = setResult(op, i, err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !response.Ok {
+		return response, nil
+	}
+
+	if err := t.tasksRepo.Bulk(ctx, batch, t.tasksCfg.MaxDepth); err != nil {
+		var bulkErr *utils.BulkTaskError
+		if !errors.As(err, &bulkErr) {
+			return nil, err
+		}
+		if err = setResult(bulkErr.Op, bulkErr.Index, bulkErr.Err); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+	for i, task := range batch.Create {
+		response.Create[i].Task = task
+	}
+	for i, task := range batch.Update {
+		response.Update[i].Task = task
+	}
+
+	for id := range cacheIDs {
+		if err := t.tasksRedisRepo.DeleteTask(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	// Moved subtasks have new ancestors
+	for _, task := range batch.Update {
+		if err := dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, task.ID); err != nil {
+			return nil, err
+		}
+	}
+	for _, taskID := range doneTaskIDs {
+		// Batch is already applied, failed notification is only traced
+		if err := notifyBudgetThresholds(ctx, t.budgetsRepo, t.projectsRepo, t.notificationsRepo, taskID); err != nil {
+			span.RecordError(err)
+		}
+	}
+	return response, nil
+}
+
+func (t tasksUC) GetEstimates(ctx context.Context, projectID int64) (*models.ProjectEstimate, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.GetEstimates")
+	defer span.End()
+
+	estimates, err := t.tasksRepo.GetEstimates(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ProjectEstimate{ProjectID: projectID, Tasks: estimates}
+	for _, estimate := range estimates {
+		result.ActualSeconds += estimate.ActualSeconds
+		if estimate.EstimateSeconds == nil {
+			continue
+		}
+		result.EstimateSeconds += *estimate.EstimateSeconds
+		result.RemainingSeconds += *estimate.RemainingSeconds
+		result.VarianceSeconds += *estimate.VarianceSeconds
+	}
+	return result, nil
+}
+
+func (t tasksUC) GetOverEstimate(ctx context.Context, projectID int64) ([]*models.TaskEstimate, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.GetOverEstimate")
+	defer span.End()
+
+	return t.tasksRepo.GetOverEstimate(ctx, projectID)
+}
+
+// GetDue returns unfinished tasks of the user which are overdue or due within the duration,
+// zero duration means the configured default
+func (t tasksUC) GetDue(ctx context.Context, userID int64, within time.Duration) (*models.DueTasks, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.GetDue")
+	defer span.End()
+
+	if within == 0 {
+		within = t.tasksCfg.DueSoon
+	}
+	tasks, err := t.tasksRepo.GetDue(ctx, userID, within)
+	if err != nil {
+		return nil, err
+	}
+
+	due := &models.DueTasks{Overdue: make([]*models.Task, 0), DueSoon: make([]*models.Task, 0)}
+	now := time.Now()
+	for _, task := range tasks {
+		if task.DueAt.Before(now) {
+			due.Overdue = append(due.Overdue, task)
+		} else {
+			due.DueSoon = append(due.DueSoon, task)
+		}
+	}
+	return due, nil
+}
+
+// RemindDue notifies members of unfinished tasks due within the duration once per due date
+// and returns amount of reminded tasks
+func (t tasksUC) RemindDue(ctx context.Context, before time.Duration) (int64, error) {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.RemindDue")
+	defer span.End()
+
+	return t.tasksRepo.ClaimDueReminders(ctx, before, func(task *models.Task) (*models.Notification, error) {
+		payload, err := json.Marshal(struct {
+			DueAt time.Time `json:"due_at"`
+		}{*task.DueAt})
+		if err != nil {
+			return nil, err
+		}
+		return &models.Notification{
+			Type:      models.NotificationDueReminder,
+			ProjectID: &task.ProjectID,
+			TaskID:    &task.ID,
+			Message:   fmt.Sprintf("Task %q is due at %s", task.Name, task.DueAt.UTC().Format(time.RFC3339)),
+			Payload:   payload,
+		}, nil
+	})
 }
 
-func (t tasksUC) Start(ctx context.Context, taskID, userID int64) error {
+// Start starts the timer of the task. Unfinished blockers are returned if the project allows starting blocked tasks
+func (t tasksUC) Start(ctx context.Context, entry *models.TimeEntry) ([]*models.DependencyTask, error) {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Start")
 	defer span.End()
 
-	return t.tasksRepo.Start(ctx, taskID, userID)
+	blockers, err := checkStart(ctx, t.entriesRepo, t.budgetsRepo, t.projectsRepo, t.tasksRepo, t.dependenciesRepo,
+		entry.TaskID, entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.tasksRepo.Start(ctx, entry, t.cfg.Policy); err != nil {
+		return nil, err
+	}
+	return blockers, dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, entry.TaskID)
+}
+
+// checkStart refuses starting a timer of the user on the task, it's shared by starting and switching timers.
+// Unfinished blockers are returned if the project only warns about them
+func checkStart(ctx context.Context, entriesRepo projects.TimeEntriesRepository, budgetsRepo projects.BudgetsRepository,
+	projectsRepo projects.Repository, tasksRepo projects.TasksRepository, dependenciesRepo projects.DependenciesRepository,
+	taskID, userID int64) ([]*models.DependencyTask, error) {
+	if err := checkLocked(ctx, entriesRepo, taskID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := checkBudget(ctx, budgetsRepo, projectsRepo, taskID, userID); err != nil {
+		return nil, err
+	}
+	return checkBlockers(ctx, tasksRepo, projectsRepo, dependenciesRepo, taskID)
+}
+
+// checkBlockers returns unfinished blockers of the task if its project only warns about them, otherwise they refuse
+// starting the task
+func checkBlockers(ctx context.Context, tasksRepo projects.TasksRepository, projectsRepo projects.Repository,
+	dependenciesRepo projects.DependenciesRepository, taskID int64) ([]*models.DependencyTask, error) {
+	blockers, err := dependenciesRepo.Get(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	unfinished := make([]*models.DependencyTask, 0, len(blockers))
+	// Ids are reported only, names are chosen by users
+	ids := make([]int64, 0, len(blockers))
+	for _, blocker := range blockers {
+		if !blocker.Done {
+			unfinished = append(unfinished, blocker)
+			ids = append(ids, blocker.ID)
+		}
+	}
+	if len(unfinished) == 0 {
+		return nil, nil
+	}
+
+	task, err := tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := projectsRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.WarnBlockedStart != nil && *project.WarnBlockedStart {
+		return unfinished, nil
+	}
+	return nil, httpErrors.NewRestError(http.StatusConflict, httpErrors.BlockedTask.Error(), ids)
 }
 
 func (t tasksUC) Stop(ctx context.Context, taskID, userID int64) error {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.Stop")
 	defer span.End()
 
-	return t.tasksRepo.Stop(ctx, taskID, userID)
+	if err := t.checkRunning(ctx, taskID, userID); err != nil {
+		return err
+	}
+	if err := t.tasksRepo.Stop(ctx, taskID, userID); err != nil {
+		return err
+	}
+	if err := dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID); err != nil {
+		return err
+	}
+
+	// The timer is already stopped, failed notification is only traced
+	if err := notifyBudgetThresholds(ctx, t.budgetsRepo, t.projectsRepo, t.notificationsRepo, taskID); err != nil {
+		span.RecordError(err)
+	}
+	return nil
+}
+
+func (t tasksUC) Pause(ctx context.Context, taskID, userID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.Pause")
+	defer span.End()
+
+	if err := t.checkRunning(ctx, taskID, userID); err != nil {
+		return err
+	}
+	if err := t.tasksRepo.Pause(ctx, taskID, userID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID)
+}
+
+func (t tasksUC) Resume(ctx context.Context, taskID, userID int64) error {
+	ctx, span := t.tracer.Start(ctx, "tasksUC.Resume")
+	defer span.End()
+
+	if err := t.checkRunning(ctx, taskID, userID); err != nil {
+		return err
+	}
+	if err := t.tasksRepo.Resume(ctx, taskID, userID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID)
+}
+
+// checkRunning refuses changing running entries of the task, which were started in locked period or approved week.
+// Such entries are left to auto-stop worker
+func (t tasksUC) checkRunning(ctx context.Context, taskID, userID int64) error {
+	active, err := t.entriesRepo.GetActive(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, entry := range active {
+		if entry.TaskID != taskID {
+			continue
+		}
+		if err = checkLocked(ctx, t.entriesRepo, taskID, entry.StartedAt); err != nil {
+			return err
+		}
+		if err = checkApproved(ctx, t.entriesRepo, &entry.TimeEntry); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (t tasksUC) GetMembers(ctx context.Context, taskID int64) ([]*models.User, error) {
@@ -73,14 +742,20 @@ func (t tasksUC) AddMember(ctx context.Context, taskID, userID int64) error {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.AddMember")
 	defer span.End()
 
-	return t.tasksRepo.AddMember(ctx, taskID, userID)
+	if err := t.tasksRepo.AddMember(ctx, taskID, userID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID)
 }
 
 func (t tasksUC) DeleteMember(ctx context.Context, taskID, userID int64) error {
 	ctx, span := t.tracer.Start(ctx, "tasksUC.DeleteMember")
 	defer span.End()
 
-	return t.tasksRepo.DeleteMember(ctx, taskID, userID)
+	if err := t.tasksRepo.DeleteMember(ctx, taskID, userID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID)
 }
 
 func (t tasksUC) IsMember(ctx context.Context, taskID, userID int64) error {
@@ -89,3 +764,70 @@ func (t tasksUC) IsMember(ctx context.Context, taskID, userID int64) error {
 
 	return t.tasksRepo.IsMember(ctx, taskID, userID)
 }
+
+// dropTaskCache drops cached detail of the task and its ancestors, which include time tracked in the task
+func dropTaskCache(ctx context.Context, tasksRepo projects.TasksRepository, tasksRedisRepo projects.TasksRedisRepository,
+	taskID int64) error {
+	ancestorIDs, err := tasksRepo.GetAncestorIDs(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	for _, id := range append(ancestorIDs, taskID) {
+		if err = tasksRedisRepo.DeleteTask(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDates refuses due date before start date and dates being set and removed at once
+func checkDates(task *models.Task) error {
+	if task.ClearStartAt && task.StartAt != nil || task.ClearDueAt && task.DueAt != nil {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.BadRequest.Error(),
+			"date can't be set and cleared at once")
+	}
+	if task.StartAt == nil || task.DueAt == nil {
+		return nil
+	}
+	if task.DueAt.Before(*task.StartAt) {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(),
+			"due_at is before start_at")
+	}
+	return nil
+}
+
+// updatedDate returns the date of the task after the update
+func updatedDate(current, update *time.Time, clear bool) *time.Time {
+	if clear {
+		return nil
+	}
+	if update != nil {
+		return update
+	}
+	return current
+}
+
+// getProjectTask returns the task if it belongs to the project
+func getProjectTask(ctx context.Context, tasksRepo projects.TasksRepository, projectID, taskID int64) (*models.Task, error) {
+	task, err := tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.ProjectID != projectID {
+		return nil, sql.ErrNoRows
+	}
+	return task, nil
+}
+
+// checkAuthorOrOwner allows moderating project content to its author, project owner and admins only
+func checkAuthorOrOwner(ctx context.Context, projectsRepo projects.Repository, user *models.User, projectID,
+	authorID int64) error {
+	if user.Admin || authorID == user.ID {
+		return nil
+	}
+	err := projectsRepo.IsOwner(ctx, projectID, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return httpErrors.NewForbiddenError("not enough permissions")
+	}
+	return err
+}
@@ -0,0 +1,360 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"time"
+)
+
+type timeEntriesUC struct {
+	entriesRepo       projects.TimeEntriesRepository
+	tasksRepo         projects.TasksRepository
+	tasksRedisRepo    projects.TasksRedisRepository
+	projectsRepo      projects.Repository
+	budgetsRepo       projects.BudgetsRepository
+	notificationsRepo projects.NotificationsRepository
+	dependenciesRepo  projects.DependenciesRepository
+	tracer            trace.Tracer
+}
+
+func NewTimeEntriesUseCase(entriesRepo projects.TimeEntriesRepository, tasksRepo projects.TasksRepository,
+	tasksRedisRepo projects.TasksRedisRepository, projectsRepo projects.Repository,
+	budgetsRepo projects.BudgetsRepository, notificationsRepo projects.NotificationsRepository,
+	dependenciesRepo projects.DependenciesRepository) projects.TimeEntriesUseCase {
+	return timeEntriesUC{
+		entriesRepo:       entriesRepo,
+		tasksRepo:         tasksRepo,
+		tasksRedisRepo:    tasksRedisRepo,
+		projectsRepo:      projectsRepo,
+		budgetsRepo:       budgetsRepo,
+		notificationsRepo: notificationsRepo,
+		dependenciesRepo:  dependenciesRepo,
+		tracer:            otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+// Get returns entries of the task of the project
+func (t timeEntriesUC) Get(ctx context.Context, projectID, taskID int64, query *utils.TimeEntriesQuery) ([]*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Get")
+	defer span.End()
+
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, taskID); err != nil {
+		return nil, err
+	}
+	return t.entriesRepo.Get(ctx, taskID, query)
+}
+
+// Export passes entries of the task of the project to fn one by one
+func (t timeEntriesUC) Export(ctx context.Context, projectID, taskID int64, query *utils.TimeEntriesQuery,
+	fn func(entry *models.TimeEntry) error) error {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Export")
+	defer span.End()
+
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, taskID); err != nil {
+		return err
+	}
+	return t.entriesRepo.Stream(ctx, taskID, query, fn)
+}
+
+// GetByID returns the entry of the task of the project
+func (t timeEntriesUC) GetByID(ctx context.Context, projectID, taskID, entryID int64) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.GetByID")
+	defer span.End()
+
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, taskID); err != nil {
+		return nil, err
+	}
+	return t.entriesRepo.GetByID(ctx, taskID, entryID)
+}
+
+func (t timeEntriesUC) Create(ctx context.Context, projectID int64, entry *models.TimeEntry) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Create")
+	defer span.End()
+
+	// Project owners skip task membership, so the task is checked to be of their project
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, entry.TaskID); err != nil {
+		return nil, err
+	}
+
+	// Manual entries are always closed, running entries are created by tasksUC.Start only
+	if entry.EndedAt == nil {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "ended_at is required")
+	}
+	if err := t.checkEditable(ctx, entry); err != nil {
+		return nil, err
+	}
+	if err := t.validate(ctx, entry); err != nil {
+		return nil, err
+	}
+	createdEntry, err := t.entriesRepo.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, entry.TaskID); err != nil {
+		return nil, err
+	}
+	return createdEntry, nil
+}
+
+func (t timeEntriesUC) Update(ctx context.Context, user *models.User, projectID, taskID int64, updates *models.TimeEntry) (*models.TimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Update")
+	defer span.End()
+
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, taskID); err != nil {
+		return nil, err
+	}
+	entry, err := t.entriesRepo.GetByID(ctx, taskID, updates.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.checkAuthor(ctx, user, projectID, entry); err != nil {
+		return nil, err
+	}
+	if err = t.checkEditable(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	// Entry moved to another task changes tracked time of both tasks
+	oldTaskID := entry.TaskID
+	if updates.TaskID != 0 && updates.TaskID != entry.TaskID {
+		// Entry can be moved to another task of the same project only
+		if err = t.entriesRepo.IsProjectTask(ctx, projectID, updates.TaskID); err != nil {
+			return nil, err
+		}
+		// Author of the entry must be a member of the task, as if the entry was tracked there
+		err = t.tasksRepo.IsMember(ctx, updates.TaskID, entry.UserID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httpErrors.NewForbiddenError("entry author isn't a member of the task")
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry.TaskID = updates.TaskID
+	}
+	if !updates.StartedAt.IsZero() {
+		entry.StartedAt = updates.StartedAt
+	}
+	if updates.EndedAt != nil {
+		entry.EndedAt = updates.EndedAt
+	}
+	if updates.Description != nil {
+		entry.Description = updates.Description
+	}
+	if updates.TagIDs != nil {
+		entry.TagIDs = updates.TagIDs
+	}
+	if updates.Billable != nil {
+		entry.Billable = updates.Billable
+	}
+
+	// Entry can't be moved into approved week either
+	if err = t.checkEditable(ctx, entry); err != nil {
+		return nil, err
+	}
+	if err = t.validate(ctx, entry); err != nil {
+		return nil, err
+	}
+	updatedEntry, err := t.entriesRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, oldTaskID); err != nil {
+		return nil, err
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, entry.TaskID); err != nil {
+		return nil, err
+	}
+	return updatedEntry, nil
+}
+
+func (t timeEntriesUC) Delete(ctx context.Context, user *models.User, projectID, taskID, entryID int64) error {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Delete")
+	defer span.End()
+
+	if err := t.entriesRepo.IsProjectTask(ctx, projectID, taskID); err != nil {
+		return err
+	}
+	entry, err := t.entriesRepo.GetByID(ctx, taskID, entryID)
+	if err != nil {
+		return err
+	}
+	if err = t.checkAuthor(ctx, user, projectID, entry); err != nil {
+		return err
+	}
+	if err = t.checkEditable(ctx, entry); err != nil {
+		return err
+	}
+	if err = t.entriesRepo.Delete(ctx, taskID, entryID); err != nil {
+		return err
+	}
+	return dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID)
+}
+
+func (t timeEntriesUC) GetActive(ctx context.Context, userID int64) ([]*models.ActiveTimeEntry, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.GetActive")
+	defer span.End()
+
+	return t.entriesRepo.GetActive(ctx, userID)
+}
+
+// Switch stops running timers of the user and starts the task. Unfinished blockers of the task are returned
+// if its project only warns about them
+func (t timeEntriesUC) Switch(ctx context.Context, user *models.User, taskID int64) (*models.TimeEntry,
+	[]*models.DependencyTask, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.Switch")
+	defer span.End()
+
+	// There is no task in the path, so membership isn't checked by middleware
+	if !user.Admin {
+		if err := t.tasksRepo.IsMember(ctx, taskID, user.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Switch stops running entries, which can't be done in approved weeks
+	active, err := t.entriesRepo.GetActive(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, entry := range active {
+		if err = t.checkEditable(ctx, &entry.TimeEntry); err != nil {
+			return nil, nil, err
+		}
+	}
+	blockers, err := checkStart(ctx, t.entriesRepo, t.budgetsRepo, t.projectsRepo, t.tasksRepo, t.dependenciesRepo,
+		taskID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry, err := t.entriesRepo.Switch(ctx, taskID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Stopped entries and the started one change tracked time of their tasks
+	for _, activeEntry := range active {
+		if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, activeEntry.TaskID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err = dropTaskCache(ctx, t.tasksRepo, t.tasksRedisRepo, taskID); err != nil {
+		return nil, nil, err
+	}
+
+	// Timers are already switched, failed notifications are only traced
+	notified := make(map[int64]bool, len(active))
+	for _, activeEntry := range active {
+		if notified[activeEntry.TaskID] {
+			continue
+		}
+		notified[activeEntry.TaskID] = true
+		if err = notifyBudgetThresholds(ctx, t.budgetsRepo, t.projectsRepo, t.notificationsRepo,
+			activeEntry.TaskID); err != nil {
+			span.RecordError(err)
+		}
+	}
+	return entry, blockers, nil
+}
+
+func (t timeEntriesUC) AutoStop(ctx context.Context, maxDuration time.Duration) (int64, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.AutoStop")
+	defer span.End()
+
+	return t.entriesRepo.AutoStop(ctx, maxDuration)
+}
+
+func (t timeEntriesUC) GetSettings(ctx context.Context, userID int64) (*models.TimerSettings, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.GetSettings")
+	defer span.End()
+
+	settings, err := t.entriesRepo.GetSettings(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.TimerSettings{UserID: userID, Timezone: "UTC"}, nil
+	}
+	return settings, err
+}
+
+func (t timeEntriesUC) UpdateSettings(ctx context.Context, settings *models.TimerSettings) (*models.TimerSettings, error) {
+	ctx, span := t.tracer.Start(ctx, "timeEntriesUC.UpdateSettings")
+	defer span.End()
+
+	return t.entriesRepo.UpdateSettings(ctx, settings)
+}
+
+// checkAuthor allows changing the entry to its author, project owner and admins only
+func (t timeEntriesUC) checkAuthor(ctx context.Context, user *models.User, projectID int64, entry *models.TimeEntry) error {
+	if user.Admin || entry.UserID == user.ID {
+		return nil
+	}
+	err := t.projectsRepo.IsOwner(ctx, projectID, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return httpErrors.NewForbiddenError("not enough permissions")
+	}
+	return err
+}
+
+// checkEditable refuses changing of the locked entry
+func (t timeEntriesUC) checkEditable(ctx context.Context, entry *models.TimeEntry) error {
+	if entry.InvoiceID != nil {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.InvoicedTimeEntry.Error(), nil)
+	}
+	if err := checkLocked(ctx, t.entriesRepo, entry.TaskID, entry.StartedAt); err != nil {
+		return err
+	}
+	return checkApproved(ctx, t.entriesRepo, entry)
+}
+
+// checkLocked refuses writing entries of the task at the moment closed by period lock
+func checkLocked(ctx context.Context, entriesRepo projects.TimeEntriesRepository, taskID int64, at time.Time) error {
+	locked, err := entriesRepo.IsLocked(ctx, taskID, at)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return httpErrors.NewRestError(http.StatusLocked, httpErrors.LockedPeriod.Error(), at.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// checkApproved refuses changing of the entry in approved timesheet week
+func checkApproved(ctx context.Context, entriesRepo projects.TimeEntriesRepository, entry *models.TimeEntry) error {
+	approved, err := entriesRepo.IsApproved(ctx, entry.TaskID, entry.UserID, entry.StartedAt)
+	if err != nil {
+		return err
+	}
+	if approved {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.ApprovedTimeEntry.Error(), nil)
+	}
+	return nil
+}
+
+// validate checks the entry against check_time constraint and other entries of the user
+func (t timeEntriesUC) validate(ctx context.Context, entry *models.TimeEntry) error {
+	if entry.StartedAt.After(time.Now()) {
+		return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "started_at is in the future")
+	}
+	if entry.EndedAt != nil {
+		if entry.EndedAt.Before(entry.StartedAt) {
+			return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "ended_at is before started_at")
+		}
+		if entry.EndedAt.After(time.Now()) {
+			return httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "ended_at is in the future")
+		}
+	}
+
+	count, err := t.entriesRepo.CountOverlapping(ctx, entry)
+	if err != nil {
+		return err
+	}
+	if count != 0 {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.OverlappingTimeEntry.Error(), nil)
+	}
+	return nil
+}
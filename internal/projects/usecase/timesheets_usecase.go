@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
+	"github.com/armanokka/time_tracker/pkg/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"time"
+)
+
+type timesheetsUC struct {
+	timesheetsRepo projects.TimesheetsRepository
+	tracer         trace.Tracer
+}
+
+func NewTimesheetsUseCase(timesheetsRepo projects.TimesheetsRepository) projects.TimesheetsUseCase {
+	return timesheetsUC{
+		timesheetsRepo: timesheetsRepo,
+		tracer:         otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+func (t timesheetsUC) Get(ctx context.Context, projectID int64, query *utils.TimesheetsQuery) ([]*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsUC.Get")
+	defer span.End()
+
+	return t.timesheetsRepo.Get(ctx, projectID, query)
+}
+
+// GetPending returns timesheets waiting for approval of the user
+func (t timesheetsUC) GetPending(ctx context.Context, user *models.User) ([]*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsUC.GetPending")
+	defer span.End()
+
+	return t.timesheetsRepo.GetPending(ctx, user.ID, user.Admin)
+}
+
+func (t timesheetsUC) Submit(ctx context.Context, timesheet *models.Timesheet) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsUC.Submit")
+	defer span.End()
+
+	if timesheet.WeekStart.Weekday() != time.Monday {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "week_start is not monday")
+	}
+	if timesheet.WeekStart.After(time.Now()) {
+		return nil, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimeRange.Error(), "week_start is in the future")
+	}
+	if err := t.checkRunning(ctx, timesheet); err != nil {
+		return nil, err
+	}
+	return t.timesheetsRepo.Submit(ctx, timesheet)
+}
+
+func (t timesheetsUC) Approve(ctx context.Context, user *models.User, projectID, timesheetID int64, comment *string) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsUC.Approve")
+	defer span.End()
+
+	return t.review(ctx, user, projectID, timesheetID, models.TimesheetStatusApproved, comment)
+}
+
+func (t timesheetsUC) Reject(ctx context.Context, user *models.User, projectID, timesheetID int64, comment string) (*models.Timesheet, error) {
+	ctx, span := t.tracer.Start(ctx, "timesheetsUC.Reject")
+	defer span.End()
+
+	return t.review(ctx, user, projectID, timesheetID, models.TimesheetStatusRejected, &comment)
+}
+
+// review allows changing the status of submitted timesheets only
+func (t timesheetsUC) review(ctx context.Context, user *models.User, projectID, timesheetID int64, status string, comment *string) (*models.Timesheet, error) {
+	timesheet, err := t.timesheetsRepo.GetByID(ctx, projectID, timesheetID)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.Status != models.TimesheetStatusSubmitted {
+		return nil, httpErrors.NewRestError(http.StatusConflict, httpErrors.InvalidTimesheetState.Error(),
+			fmt.Sprintf("%s -> %s", timesheet.Status, status))
+	}
+	if status == models.TimesheetStatusApproved {
+		if err = t.checkRunning(ctx, timesheet); err != nil {
+			return nil, err
+		}
+	}
+
+	timesheet.Status = status
+	timesheet.Comment = comment
+	timesheet.ReviewedBy = &user.ID
+	return t.timesheetsRepo.Review(ctx, timesheet)
+}
+
+// checkRunning refuses the week with running entries, they couldn't be stopped once the week is approved
+func (t timesheetsUC) checkRunning(ctx context.Context, timesheet *models.Timesheet) error {
+	running, err := t.timesheetsRepo.HasRunningEntries(ctx, timesheet.ProjectID, timesheet.UserID, timesheet.WeekStart)
+	if err != nil {
+		return err
+	}
+	if running {
+		return httpErrors.NewRestError(http.StatusConflict, httpErrors.RunningTimeEntry.Error(), nil)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/armanokka/time_tracker/internal/models"
 	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/utils"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -120,9 +121,9 @@ func (c projectsUC) RemoveMember(ctx context.Context, projectID, userID int64) e
 	return c.repo.RemoveMember(ctx, projectID, userID)
 }
 
-func (c projectsUC) GetMemberProductivity(ctx context.Context, projectID, userID int64) ([]models.UserProductivity, error) {
+func (c projectsUC) GetMemberProductivity(ctx context.Context, projectID, userID int64, query *utils.ProductivityQuery) ([]models.UserProductivity, error) {
 	ctx, span := c.tracer.Start(ctx, "projectsUC.GetMemberProductivity")
 	defer span.End()
 
-	return c.repo.GetMemberProductivity(ctx, projectID, userID)
+	return c.repo.GetMemberProductivity(ctx, projectID, userID, query)
 }
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/config"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// AttachmentsCleanupWorker periodically removes stored files of deleted attachments
+type AttachmentsCleanupWorker struct {
+	cfg           config.AttachmentsConfig
+	attachmentsUC projects.AttachmentsUseCase
+	log           logger.Logger
+	tracer        trace.Tracer
+}
+
+func NewAttachmentsCleanupWorker(cfg config.AttachmentsConfig, attachmentsUC projects.AttachmentsUseCase,
+	log logger.Logger) AttachmentsCleanupWorker {
+	return AttachmentsCleanupWorker{
+		cfg:           cfg,
+		attachmentsUC: attachmentsUC,
+		log:           log,
+		tracer:        otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+// Run blocks until ctx is done
+func (w AttachmentsCleanupWorker) Run(ctx context.Context) {
+	if w.cfg.CleanupInterval <= 0 {
+		w.log.Info("Attachments cleanup worker is disabled")
+		return
+	}
+	w.log.Infof("Attachments cleanup worker is running every %s", w.cfg.CleanupInterval)
+
+	ticker := time.NewTicker(w.cfg.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.cleanup(ctx)
+		}
+	}
+}
+
+func (w AttachmentsCleanupWorker) cleanup(ctx context.Context) {
+	ctx, span := w.tracer.Start(ctx, "AttachmentsCleanupWorker.cleanup")
+	defer span.End()
+
+	removed, err := w.attachmentsUC.CleanupOrphans(ctx)
+	if err != nil {
+		w.log.Errorf("Error attachmentsUC.CleanupOrphans, ERROR: %s", err.Error())
+	}
+	if removed != 0 {
+		w.log.Infof("Removed %d stored files of deleted attachments", removed)
+	}
+}
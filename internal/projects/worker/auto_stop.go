@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/config"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// AutoStopWorker periodically stops time entries that users forgot to stop
+type AutoStopWorker struct {
+	cfg       config.AutoStopConfig
+	entriesUC projects.TimeEntriesUseCase
+	log       logger.Logger
+	tracer    trace.Tracer
+}
+
+func NewAutoStopWorker(cfg config.AutoStopConfig, entriesUC projects.TimeEntriesUseCase, log logger.Logger) AutoStopWorker {
+	return AutoStopWorker{
+		cfg:       cfg,
+		entriesUC: entriesUC,
+		log:       log,
+		tracer:    otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+// Run blocks until ctx is done
+func (w AutoStopWorker) Run(ctx context.Context) {
+	if w.cfg.Interval <= 0 {
+		w.log.Info("Auto stop worker is disabled")
+		return
+	}
+	w.log.Infof("Auto stop worker is running every %s", w.cfg.Interval)
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.stopForgotten(ctx)
+		}
+	}
+}
+
+func (w AutoStopWorker) stopForgotten(ctx context.Context) {
+	ctx, span := w.tracer.Start(ctx, "AutoStopWorker.stopForgotten")
+	defer span.End()
+
+	stopped, err := w.entriesUC.AutoStop(ctx, w.cfg.MaxDuration)
+	if err != nil {
+		w.log.Errorf("Error entriesUC.AutoStop, ERROR: %s", err.Error())
+		return
+	}
+	if stopped != 0 {
+		w.log.Infof("Auto stopped %d forgotten time entries", stopped)
+	}
+}
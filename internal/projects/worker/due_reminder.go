@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"github.com/armanokka/time_tracker/config"
+	"github.com/armanokka/time_tracker/internal/projects"
+	"github.com/armanokka/time_tracker/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// DueReminderWorker periodically reminds task members about approaching due dates
+type DueReminderWorker struct {
+	cfg     config.DueRemindersConfig
+	tasksUC projects.TasksUseCase
+	log     logger.Logger
+	tracer  trace.Tracer
+}
+
+func NewDueReminderWorker(cfg config.DueRemindersConfig, tasksUC projects.TasksUseCase, log logger.Logger) DueReminderWorker {
+	return DueReminderWorker{
+		cfg:     cfg,
+		tasksUC: tasksUC,
+		log:     log,
+		tracer:  otel.GetTracerProvider().Tracer("api"),
+	}
+}
+
+// Run blocks until ctx is done
+func (w DueReminderWorker) Run(ctx context.Context) {
+	if w.cfg.Interval <= 0 {
+		w.log.Info("Due reminder worker is disabled")
+		return
+	}
+	w.log.Infof("Due reminder worker is running every %s", w.cfg.Interval)
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.remind(ctx)
+		}
+	}
+}
+
+func (w DueReminderWorker) remind(ctx context.Context) {
+	ctx, span := w.tracer.Start(ctx, "DueReminderWorker.remind")
+	defer span.End()
+
+	reminded, err := w.tasksUC.RemindDue(ctx, w.cfg.Before)
+	if err != nil {
+		w.log.Errorf("Error tasksUC.RemindDue, ERROR: %s", err.Error())
+		return
+	}
+	if reminded != 0 {
+		w.log.Infof("Reminded members of %d tasks about due dates", reminded)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	authHttp "github.com/armanokka/time_tracker/internal/auth/delivery/http"
 	authRepo "github.com/armanokka/time_tracker/internal/auth/repository"
 	authUc "github.com/armanokka/time_tracker/internal/auth/usecase"
@@ -8,27 +9,91 @@ import (
 	projectsHttp "github.com/armanokka/time_tracker/internal/projects/delivery/http"
 	projectsRepo "github.com/armanokka/time_tracker/internal/projects/repository"
 	projectsUc "github.com/armanokka/time_tracker/internal/projects/usecase"
+	projectsWorker "github.com/armanokka/time_tracker/internal/projects/worker"
 	"github.com/gin-gonic/gin"
 )
 
-func (s Server) MapHandlers(c *gin.RouterGroup) {
+func (s Server) MapHandlers(ctx context.Context, c *gin.RouterGroup) {
 	aRepo := authRepo.NewAuthRepository(s.db)                                  // auth repository
 	aRedisRepo := authRepo.NewAuthRedisRepo(s.rdb)                             // auth redis repository
 	aUseCase := authUc.NewAuthUseCase(s.cfg.Server, aRepo, aRedisRepo)         // auth use case
 	authHandlers := authHttp.NewAuthHandlers(s.cfg.Server, aUseCase, s.logger) // auth handlers
 
-	projRepo := projectsRepo.NewProjectsRepository(s.db)      // projects repository
-	projRedisRepo := projectsRepo.NewProjectsRedisRepo(s.rdb) // projects redis repository
-	tasksRepo := projectsRepo.NewTasksRepository(s.db)        // tasks repository
+	projRepo := projectsRepo.NewProjectsRepository(s.db)               // projects repository
+	projRedisRepo := projectsRepo.NewProjectsRedisRepo(s.rdb)          // projects redis repository
+	tasksRepo := projectsRepo.NewTasksRepository(s.db)                 // tasks repository
+	tasksRedisRepo := projectsRepo.NewTasksRedisRepo(s.rdb)            // tasks redis repository
+	entriesRepo := projectsRepo.NewTimeEntriesRepository(s.db)         // time entries repository
+	tagsRepo := projectsRepo.NewTagsRepository(s.db)                   // tags repository
+	ratesRepo := projectsRepo.NewRatesRepository(s.db)                 // hourly rates repository
+	invoicesRepo := projectsRepo.NewInvoicesRepository(s.db)           // invoices repository
+	timesheetsRepo := projectsRepo.NewTimesheetsRepository(s.db)       // timesheets repository
+	locksRepo := projectsRepo.NewLocksRepository(s.db)                 // period locks repository
+	reportsRepo := projectsRepo.NewReportsRepository(s.db)             // reports repository
+	budgetsRepo := projectsRepo.NewBudgetsRepository(s.db)             // project budgets repository
+	notificationsRepo := projectsRepo.NewNotificationsRepository(s.db) // notifications repository
+	statusesRepo := projectsRepo.NewStatusesRepository(s.db)           // task statuses repository
+	dependenciesRepo := projectsRepo.NewDependenciesRepository(s.db)   // task dependencies repository
+	commentsRepo := projectsRepo.NewCommentsRepository(s.db)           // task comments repository
+	attachmentsRepo := projectsRepo.NewAttachmentsRepository(s.db)     // task attachments repository
+	labelsRepo := projectsRepo.NewLabelsRepository(s.db)               // task labels repository
 
 	projectsUC := projectsUc.NewProjectsUseCase(projRepo, projRedisRepo) // projects use case
-	tasksUC := projectsUc.NewTasksUseCase(tasksRepo)                     // tasks use case
+	tasksUC := projectsUc.NewTasksUseCase(s.cfg.Timer, s.cfg.Tasks, tasksRepo, tasksRedisRepo, entriesRepo, projRepo,
+		budgetsRepo, notificationsRepo, statusesRepo, dependenciesRepo) // tasks use case
+	entriesUC := projectsUc.NewTimeEntriesUseCase(entriesRepo, tasksRepo, tasksRedisRepo,
+		projRepo, budgetsRepo, notificationsRepo, dependenciesRepo) // time entries use case
+	tagsUC := projectsUc.NewTagsUseCase(tagsRepo)                            // tags use case
+	ratesUC := projectsUc.NewRatesUseCase(ratesRepo, projRepo)               // hourly rates use case
+	invoicesUC := projectsUc.NewInvoicesUseCase(invoicesRepo, projRepo)      // invoices use case
+	timesheetsUC := projectsUc.NewTimesheetsUseCase(timesheetsRepo)          // timesheets use case
+	locksUC := projectsUc.NewLocksUseCase(locksRepo)                         // period locks use case
+	reportsUC := projectsUc.NewReportsUseCase(reportsRepo)                   // reports use case
+	budgetsUC := projectsUc.NewBudgetsUseCase(budgetsRepo)                   // project budgets use case
+	notificationsUC := projectsUc.NewNotificationsUseCase(notificationsRepo) // notifications use case
+	statusesUC := projectsUc.NewStatusesUseCase(statusesRepo)                // task statuses use case
+	dependenciesUC := projectsUc.NewDependenciesUseCase(dependenciesRepo, tasksRepo,
+		tasksRedisRepo) // task dependencies use case
+	commentsUC := projectsUc.NewCommentsUseCase(commentsRepo, tasksRepo, projRepo,
+		notificationsRepo) // task comments use case
+	attachmentsUC := projectsUc.NewAttachmentsUseCase(s.cfg.Attachments, attachmentsRepo, tasksRepo, projRepo,
+		s.storage) // task attachments use case
+	labelsUC := projectsUc.NewLabelsUseCase(labelsRepo, tasksRepo, tasksRedisRepo) // task labels use case
 
-	projectsHandlers := projectsHttp.NewProjectsHandlers(s.cfg.Server, projectsUC, s.logger) // projects handlers
-	tasksHandlers := projectsHttp.NewTasksHandlers(tasksUC, s.logger)                        // tasks handlers
+	projectsHandlers := projectsHttp.NewProjectsHandlers(s.cfg.Server, projectsUC, s.logger)  // projects handlers
+	tasksHandlers := projectsHttp.NewTasksHandlers(tasksUC, s.logger)                         // tasks handlers
+	entriesHandlers := projectsHttp.NewTimeEntriesHandlers(entriesUC, s.logger)               // time entries handlers
+	tagsHandlers := projectsHttp.NewTagsHandlers(tagsUC, s.logger)                            // tags handlers
+	ratesHandlers := projectsHttp.NewRatesHandlers(ratesUC, s.logger)                         // hourly rates handlers
+	invoicesHandlers := projectsHttp.NewInvoicesHandlers(invoicesUC, s.logger)                // invoices handlers
+	timesheetsHandlers := projectsHttp.NewTimesheetsHandlers(timesheetsUC, s.logger)          // timesheets handlers
+	locksHandlers := projectsHttp.NewLocksHandlers(locksUC, s.logger)                         // period locks handlers
+	reportsHandlers := projectsHttp.NewReportsHandlers(reportsUC, s.logger)                   // reports handlers
+	budgetsHandlers := projectsHttp.NewBudgetsHandlers(budgetsUC, s.logger)                   // project budgets handlers
+	notificationsHandlers := projectsHttp.NewNotificationsHandlers(notificationsUC, s.logger) // notifications handlers
+	statusesHandlers := projectsHttp.NewStatusesHandlers(statusesUC, s.logger)                // task statuses handlers
+	dependenciesHandlers := projectsHttp.NewDependenciesHandlers(dependenciesUC, s.logger)    // task dependencies handlers
+	commentsHandlers := projectsHttp.NewCommentsHandlers(commentsUC, s.logger)                // task comments handlers
+	attachmentsHandlers := projectsHttp.NewAttachmentsHandlers(s.cfg.Attachments, attachmentsUC,
+		s.logger) // task attachments handlers
+	labelsHandlers := projectsHttp.NewLabelsHandlers(labelsUC, s.logger) // task labels handlers
 
 	mw := middleware.NewMiddlewareManager(s.cfg.Server, []string{"*"}, s.logger, aUseCase, projectsUC, tasksUC)
 
 	authHttp.MapAuthRoutes(c.Group("/users"), authHandlers, mw)
-	projectsHttp.MapProjectsTasksRoutes(c.Group("/projects"), projectsHandlers, tasksHandlers, mw)
+	projectsHttp.MapProjectsTasksRoutes(c.Group("/projects"), projectsHandlers, tasksHandlers, entriesHandlers,
+		tagsHandlers, ratesHandlers, invoicesHandlers, timesheetsHandlers, locksHandlers, budgetsHandlers,
+		statusesHandlers, dependenciesHandlers, commentsHandlers, attachmentsHandlers, labelsHandlers, mw)
+	projectsHttp.MapRatesRoutes(c.Group("/users/:user_id/rates"), ratesHandlers, mw)
+	projectsHttp.MapLocksRoutes(c.Group("/locks"), locksHandlers, mw)
+	projectsHttp.MapReportsRoutes(c.Group("/reports"), reportsHandlers, mw)
+	projectsHttp.MapTimesheetsRoutes(c.Group("/timesheets"), timesheetsHandlers, mw)
+	projectsHttp.MapNotificationsRoutes(c.Group("/users/me/notifications"), notificationsHandlers, mw)
+	projectsHttp.MapTimerRoutes(c.Group("/users/me"), c.Group("/timer"), entriesHandlers, mw)
+	projectsHttp.MapUserTasksRoutes(c.Group("/users/me"), tasksHandlers, mw)
+
+	go projectsWorker.NewAutoStopWorker(s.cfg.AutoStop, entriesUC, s.logger).Run(ctx)      // stops forgotten timers
+	go projectsWorker.NewDueReminderWorker(s.cfg.DueReminders, tasksUC, s.logger).Run(ctx) // reminds about due dates
+	go projectsWorker.NewAttachmentsCleanupWorker(s.cfg.Attachments, attachmentsUC,
+		s.logger).Run(ctx) // removes files of deleted attachments
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/armanokka/time_tracker/config"
 	_ "github.com/armanokka/time_tracker/docs"
 	"github.com/armanokka/time_tracker/pkg/logger"
+	"github.com/armanokka/time_tracker/pkg/storage"
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
@@ -26,16 +27,18 @@ const (
 
 // Server struct
 type Server struct {
-	router *gin.Engine
-	cfg    *config.Config
-	db     *sqlx.DB
-	rdb    *redis.Client
-	logger logger.Logger
+	router  *gin.Engine
+	cfg     *config.Config
+	db      *sqlx.DB
+	rdb     *redis.Client
+	storage storage.Storage
+	logger  logger.Logger
 }
 
 // NewServer New Server constructor
-func NewServer(cfg *config.Config, db *sqlx.DB, redisClient *redis.Client, logger logger.Logger) *Server {
-	return &Server{router: gin.Default(), cfg: cfg, db: db, rdb: redisClient, logger: logger}
+func NewServer(cfg *config.Config, db *sqlx.DB, redisClient *redis.Client, storage storage.Storage,
+	logger logger.Logger) *Server {
+	return &Server{router: gin.Default(), cfg: cfg, db: db, rdb: redisClient, storage: storage, logger: logger}
 }
 
 func (s Server) Run(ctx context.Context) error {
@@ -61,7 +64,7 @@ func (s Server) Run(ctx context.Context) error {
 		}
 	}()
 
-	s.MapHandlers(s.router.Group("/api"))
+	s.MapHandlers(ctx, s.router.Group("/api"))
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
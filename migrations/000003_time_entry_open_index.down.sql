@@ -0,0 +1 @@
+drop index time_entry_open_task_id_user_id_idx;
@@ -0,0 +1,12 @@
+-- only the latest open entry of the user on the task is kept running
+update time_entry
+set ended_at = now()
+where ended_at is null
+  and id not in (select max(id)
+                 from time_entry
+                 where ended_at is null
+                 group by task_id, user_id);
+
+create unique index time_entry_open_task_id_user_id_idx
+    on time_entry (task_id, user_id)
+    where ended_at is null;
@@ -0,0 +1,3 @@
+drop trigger time_entry_close_pauses on time_entry;
+drop function close_time_entry_pauses;
+drop table time_entry_pause;
@@ -0,0 +1,38 @@
+create table time_entry_pause
+(
+    id            bigserial
+        primary key,
+    time_entry_id bigint not null
+        constraint fk_time_entry_pause_time_entry
+            references time_entry
+            on update cascade on delete cascade,
+    started_at    timestamp with time zone default CURRENT_TIMESTAMP not null,
+    ended_at      timestamp with time zone
+);
+
+alter table time_entry_pause
+    add constraint check_pause_time
+        check (started_at <= ended_at);
+
+create unique index time_entry_pause_open_time_entry_id_idx
+    on time_entry_pause (time_entry_id)
+    where ended_at is null;
+
+-- stopping the entry finishes its running pause
+create function close_time_entry_pauses() returns trigger as
+$$
+begin
+    update time_entry_pause
+    set ended_at = greatest(started_at, new.ended_at)
+    where time_entry_id = new.id
+      and ended_at is null;
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger time_entry_close_pauses
+    after update of ended_at
+    on time_entry
+    for each row
+    when (new.ended_at is not null)
+execute function close_time_entry_pauses();
@@ -0,0 +1,2 @@
+drop table user_timer_settings;
+alter table time_entry drop column auto_stopped;
@@ -0,0 +1,13 @@
+alter table time_entry
+    add auto_stopped boolean default false not null;
+
+create table user_timer_settings
+(
+    user_id     bigint                not null
+        primary key
+        constraint fk_user_timer_settings_user
+            references "user"
+            on update cascade on delete cascade,
+    workday_end time,
+    timezone    text default 'UTC' not null
+);
@@ -0,0 +1,3 @@
+drop table time_entry_tag;
+drop table tag;
+alter table time_entry drop column description;
@@ -0,0 +1,31 @@
+alter table time_entry
+    add description text;
+
+create table tag
+(
+    id         bigserial
+        primary key,
+    project_id bigint not null
+        constraint fk_tag_project
+            references project
+            on update cascade on delete cascade,
+    name       text   not null
+);
+
+create unique index tag_project_id_name_idx
+    on tag (project_id, name);
+
+create table time_entry_tag
+(
+    time_entry_id bigint not null
+        constraint fk_time_entry_tag_time_entry
+            references time_entry
+            on update cascade on delete cascade,
+    tag_id        bigint not null
+        constraint fk_time_entry_tag_tag
+            references tag
+            on update cascade on delete cascade
+);
+
+create unique index time_entry_id_tag_id_idx
+    on time_entry_tag (time_entry_id, tag_id);
@@ -0,0 +1,6 @@
+drop table hourly_rate;
+drop trigger time_entry_set_billable on time_entry;
+drop function set_time_entry_billable;
+alter table time_entry drop column billable;
+alter table task drop column billable;
+alter table project drop column billable;
@@ -0,0 +1,62 @@
+alter table project
+    add billable boolean default false not null;
+
+-- null means the task inherits billable flag of the project
+alter table task
+    add billable boolean;
+
+alter table time_entry
+    add billable boolean;
+
+update time_entry
+set billable = coalesce(task.billable, project.billable)
+from task
+         inner join project on project.id = task.project_id
+where task.id = time_entry.task_id;
+
+alter table time_entry
+    alter column billable set not null;
+
+-- entries created without billable flag take it from the task or the project
+create function set_time_entry_billable() returns trigger as
+$$
+begin
+    select coalesce(task.billable, project.billable)
+    into new.billable
+    from task
+             inner join project on project.id = task.project_id
+    where task.id = new.task_id;
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger time_entry_set_billable
+    before insert
+    on time_entry
+    for each row
+    when (new.billable is null)
+execute function set_time_entry_billable();
+
+-- user rate without project is the workspace rate, project rate without user applies to all members
+create table hourly_rate
+(
+    id             bigserial
+        primary key,
+    user_id        bigint
+        constraint fk_hourly_rate_user
+            references "user"
+            on update cascade on delete cascade,
+    project_id     bigint
+        constraint fk_hourly_rate_project
+            references project
+            on update cascade on delete cascade,
+    rate           numeric(12, 2) not null,
+    effective_from date           not null
+);
+
+alter table hourly_rate
+    add constraint check_hourly_rate
+        check (rate >= 0 and (user_id is not null or project_id is not null));
+
+create unique index hourly_rate_user_id_project_id_effective_from_idx
+    on hourly_rate (coalesce(user_id, 0), coalesce(project_id, 0), effective_from);
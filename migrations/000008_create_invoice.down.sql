@@ -0,0 +1,3 @@
+alter table time_entry drop column invoice_id;
+drop table invoice_line;
+drop table invoice;
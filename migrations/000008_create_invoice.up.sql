@@ -0,0 +1,63 @@
+create table invoice
+(
+    id          bigserial
+        primary key,
+    number      bigint                                             not null
+        constraint uni_invoice_number
+            unique,
+    project_id  bigint                                             not null
+        constraint fk_invoice_project
+            references project
+            on update cascade on delete cascade,
+    created_by  bigint                                             not null
+        constraint fk_invoice_user
+            references "user"
+            on update cascade on delete cascade,
+    period_from date                                               not null,
+    period_to   date                                               not null,
+    group_by    text                     default 'task'            not null,
+    tax_rate    numeric(5, 2)            default 0                 not null,
+    subtotal    numeric(12, 2)           default 0                 not null,
+    tax         numeric(12, 2)           default 0                 not null,
+    total       numeric(12, 2)           default 0                 not null,
+    status      text                     default 'draft'           not null,
+    created_at  timestamp with time zone default CURRENT_TIMESTAMP not null,
+    sent_at     timestamp with time zone,
+    paid_at     timestamp with time zone
+);
+
+alter table invoice
+    add constraint check_invoice
+        check (period_from <= period_to and tax_rate >= 0 and group_by in ('task', 'member') and
+               status in ('draft', 'sent', 'paid'));
+
+create table invoice_line
+(
+    id          bigserial
+        primary key,
+    invoice_id  bigint         not null
+        constraint fk_invoice_line_invoice
+            references invoice
+            on update cascade on delete cascade,
+    task_id     bigint
+        constraint fk_invoice_line_task
+            references task
+            on update cascade on delete set null,
+    user_id     bigint
+        constraint fk_invoice_line_user
+            references "user"
+            on update cascade on delete set null,
+    description text           not null,
+    seconds     bigint         not null,
+    amount      numeric(12, 2) not null
+);
+
+-- deleting the draft invoice releases its entries
+alter table time_entry
+    add invoice_id bigint
+        constraint fk_time_entry_invoice
+            references invoice
+            on update cascade on delete set null;
+
+create index time_entry_invoice_id_idx
+    on time_entry (invoice_id);
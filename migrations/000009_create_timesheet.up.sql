@@ -0,0 +1,33 @@
+create table timesheet
+(
+    id           bigserial
+        primary key,
+    project_id   bigint                                             not null
+        constraint fk_timesheet_project
+            references project
+            on update cascade on delete cascade,
+    user_id      bigint                                             not null
+        constraint fk_timesheet_user
+            references "user"
+            on update cascade on delete cascade,
+    week_start   date                                               not null,
+    status       text                     default 'submitted'       not null,
+    comment      text,
+    submitted_at timestamp with time zone default CURRENT_TIMESTAMP not null,
+    reviewed_by  bigint
+        constraint fk_timesheet_reviewer
+            references "user"
+            on update cascade on delete set null,
+    reviewed_at  timestamp with time zone
+);
+
+alter table timesheet
+    add constraint check_timesheet
+        check (extract(isodow from week_start) = 1 and status in ('submitted', 'approved', 'rejected'));
+
+create unique index timesheet_project_id_user_id_week_start_idx
+    on timesheet (project_id, user_id, week_start);
+
+create index timesheet_status_idx
+    on timesheet (status)
+    where status = 'submitted';
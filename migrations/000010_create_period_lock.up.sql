@@ -0,0 +1,19 @@
+create table period_lock
+(
+    id           bigserial
+        primary key,
+    project_id   bigint
+        constraint fk_period_lock_project
+            references project
+            on update cascade on delete cascade,
+    locked_until date                                               not null,
+    updated_by   bigint
+        constraint fk_period_lock_user
+            references "user"
+            on update cascade on delete set null,
+    updated_at   timestamp with time zone default CURRENT_TIMESTAMP not null
+);
+
+-- One global lock and one lock per project
+create unique index period_lock_project_id_idx
+    on period_lock (coalesce(project_id, 0));
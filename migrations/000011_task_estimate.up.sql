@@ -0,0 +1,7 @@
+-- null means the task has no estimate
+alter table task
+    add estimate_seconds bigint;
+
+alter table task
+    add constraint check_task_estimate
+        check (estimate_seconds > 0);
@@ -0,0 +1,3 @@
+drop table notification;
+drop table budget_alert;
+drop table project_budget;
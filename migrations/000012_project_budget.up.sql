@@ -0,0 +1,67 @@
+-- amount is hours or money depending on kind, monthly budget is consumed by entries started in the calendar month
+create table project_budget
+(
+    project_id  bigint                                             not null
+        primary key
+        constraint fk_project_budget_project
+            references project
+            on update cascade on delete cascade,
+    kind        varchar(16)                                        not null,
+    amount      numeric(14, 2)                                     not null,
+    period      varchar(16)                                        not null,
+    thresholds  integer[]                default '{50,80,100}'     not null,
+    block_start boolean                  default false             not null,
+    updated_by  bigint
+        constraint fk_project_budget_user
+            references "user"
+            on update cascade on delete set null,
+    updated_at  timestamp with time zone default CURRENT_TIMESTAMP not null
+);
+
+alter table project_budget
+    add constraint check_project_budget
+        check (kind in ('hours', 'money') and period in ('total', 'monthly') and amount > 0);
+
+-- crossed thresholds of the budget period, every threshold is notified once per period.
+-- period_start is null for total budget
+create table budget_alert
+(
+    id           bigserial
+        primary key,
+    project_id   bigint                                             not null
+        constraint fk_budget_alert_project
+            references project
+            on update cascade on delete cascade,
+    period_start date,
+    threshold    integer                                            not null,
+    created_at   timestamp with time zone default CURRENT_TIMESTAMP not null
+);
+
+create unique index budget_alert_project_id_period_start_threshold_idx
+    on budget_alert (project_id, coalesce(period_start, '-infinity'::date), threshold);
+
+create table notification
+(
+    id         bigserial
+        primary key,
+    user_id    bigint                                             not null
+        constraint fk_notification_user
+            references "user"
+            on update cascade on delete cascade,
+    type       varchar(32)                                        not null,
+    project_id bigint
+        constraint fk_notification_project
+            references project
+            on update cascade on delete cascade,
+    task_id    bigint
+        constraint fk_notification_task
+            references task
+            on update cascade on delete cascade,
+    message    text                                               not null,
+    payload    jsonb                    default '{}'              not null,
+    created_at timestamp with time zone default CURRENT_TIMESTAMP not null,
+    read_at    timestamp with time zone
+);
+
+create index notification_user_id_idx
+    on notification (user_id, id desc);
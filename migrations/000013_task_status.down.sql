@@ -0,0 +1,10 @@
+drop trigger task_set_status on task;
+drop function set_task_status;
+alter table task add column finished boolean default false not null;
+update task set finished = task_status.category = 'done' from task_status where task_status.id = task.status_id;
+alter table task drop column status_id;
+drop trigger project_create_task_statuses on project;
+drop function create_default_task_statuses;
+alter table project drop column start_in_progress;
+drop table task_status_transition;
+drop table task_status;
@@ -0,0 +1,102 @@
+-- category tells what the status means for the timer: starting moves todo tasks to in_progress, done stops entries
+create table task_status
+(
+    id         bigserial
+        primary key,
+    project_id bigint            not null
+        constraint fk_task_status_project
+            references project
+            on update cascade on delete cascade,
+    name       varchar(64)       not null,
+    category   varchar(16)       not null,
+    position   integer default 0 not null
+);
+
+alter table task_status
+    add constraint check_task_status_category
+        check (category in ('todo', 'in_progress', 'done'));
+
+create unique index task_status_project_id_name_idx
+    on task_status (project_id, lower(name));
+
+-- status without outgoing transitions can be changed to any status of the project
+create table task_status_transition
+(
+    from_status_id bigint not null
+        constraint fk_task_status_transition_from
+            references task_status
+            on update cascade on delete cascade,
+    to_status_id   bigint not null
+        constraint fk_task_status_transition_to
+            references task_status
+            on update cascade on delete cascade,
+    primary key (from_status_id, to_status_id)
+);
+
+alter table project
+    add start_in_progress boolean default false not null;
+
+create function create_default_task_statuses() returns trigger as
+$$
+begin
+    insert into task_status (project_id, name, category, position)
+    values (new.id, 'backlog', 'todo', 0),
+           (new.id, 'in progress', 'in_progress', 1),
+           (new.id, 'review', 'in_progress', 2),
+           (new.id, 'done', 'done', 3);
+    return new;
+end;
+$$ language plpgsql;
+
+insert into task_status (project_id, name, category, position)
+select project.id, defaults.name, defaults.category, defaults.position
+from project
+         cross join (values ('backlog', 'todo', 0),
+                            ('in progress', 'in_progress', 1),
+                            ('review', 'in_progress', 2),
+                            ('done', 'done', 3)) defaults(name, category, position);
+
+create trigger project_create_task_statuses
+    after insert
+    on project
+    for each row
+execute function create_default_task_statuses();
+
+alter table task
+    add status_id bigint
+        constraint fk_task_status
+            references task_status
+            on update cascade on delete restrict;
+
+update task
+set status_id = task_status.id
+from task_status
+where task_status.project_id = task.project_id
+  and task_status.category = case when task.finished then 'done' else 'todo' end;
+
+alter table task
+    alter column status_id set not null;
+
+alter table task
+    drop column finished;
+
+-- tasks created without status take the first todo status of the project
+create function set_task_status() returns trigger as
+$$
+begin
+    select task_status.id
+    into new.status_id
+    from task_status
+    where task_status.project_id = new.project_id
+    order by task_status.category <> 'todo', task_status.position, task_status.id
+    limit 1;
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger task_set_status
+    before insert
+    on task
+    for each row
+    when (new.status_id is null)
+execute function set_task_status();
@@ -0,0 +1,6 @@
+drop index task_project_id_updated_at_idx;
+drop index task_project_id_created_at_idx;
+drop trigger task_set_updated_at on task;
+drop function set_task_updated_at;
+alter table task drop column updated_at;
+alter table task drop column created_at;
@@ -0,0 +1,26 @@
+-- existing tasks get the migration time, there is no better guess
+alter table task
+    add created_at timestamp with time zone default now() not null;
+
+alter table task
+    add updated_at timestamp with time zone default now() not null;
+
+create function set_task_updated_at() returns trigger as
+$$
+begin
+    new.updated_at = now();
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger task_set_updated_at
+    before update
+    on task
+    for each row
+execute function set_task_updated_at();
+
+create index task_project_id_created_at_idx
+    on task (project_id, created_at, id);
+
+create index task_project_id_updated_at_idx
+    on task (project_id, updated_at, id);
@@ -0,0 +1,2 @@
+drop function task_root_id;
+alter table task drop column parent_id;
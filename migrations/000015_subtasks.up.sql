@@ -0,0 +1,28 @@
+-- subtasks of the deleted task become top-level tasks, so their entries are kept
+alter table task
+    add parent_id bigint
+        constraint fk_task_parent
+            references task
+            on update cascade on delete set null;
+
+alter table task
+    add constraint check_task_parent
+        check (parent_id <> id);
+
+create index task_parent_id_idx
+    on task (parent_id);
+
+-- task_root_id returns the top-level ancestor of the task, the task itself if it isn't a subtask
+create function task_root_id(bigint) returns bigint as
+$$
+with recursive ancestor as (select id, parent_id
+                            from task
+                            where id = $1
+                            union all
+                            select task.id, task.parent_id
+                            from task
+                                     inner join ancestor on task.id = ancestor.parent_id)
+select id
+from ancestor
+where parent_id is null;
+$$ language sql stable;
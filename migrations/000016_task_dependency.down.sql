@@ -0,0 +1,2 @@
+alter table project drop column warn_blocked_start;
+drop table task_dependency;
@@ -0,0 +1,24 @@
+-- task can't be started until its blockers are done
+create table task_dependency
+(
+    task_id    bigint not null
+        constraint fk_task_dependency_task
+            references task
+            on update cascade on delete cascade,
+    blocker_id bigint not null
+        constraint fk_task_dependency_blocker
+            references task
+            on update cascade on delete cascade,
+    primary key (task_id, blocker_id)
+);
+
+alter table task_dependency
+    add constraint check_task_dependency_self
+        check (task_id <> blocker_id);
+
+create index task_dependency_blocker_id_idx
+    on task_dependency (blocker_id);
+
+-- blocked tasks are started with a warning instead of being refused
+alter table project
+    add warn_blocked_start boolean default false not null;
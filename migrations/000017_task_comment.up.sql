@@ -0,0 +1,21 @@
+-- markdown comments of the task, mentions are ids of mentioned project members
+create table task_comment
+(
+    id         bigserial
+        primary key,
+    task_id    bigint                                             not null
+        constraint fk_task_comment_task
+            references task
+            on update cascade on delete cascade,
+    author_id  bigint                                             not null
+        constraint fk_task_comment_author
+            references "user"
+            on update cascade on delete cascade,
+    body       text                                               not null,
+    mentions   bigint[]                 default '{}'              not null,
+    created_at timestamp with time zone default CURRENT_TIMESTAMP not null,
+    edited_at  timestamp with time zone
+);
+
+create index task_comment_task_id_idx
+    on task_comment (task_id, id);
@@ -0,0 +1 @@
+drop table task_attachment;
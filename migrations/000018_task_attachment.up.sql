@@ -0,0 +1,24 @@
+-- files attached to the task, contents are kept in the storage by storage_key
+create table task_attachment
+(
+    id           bigserial
+        primary key,
+    task_id      bigint                                             not null
+        constraint fk_task_attachment_task
+            references task
+            on update cascade on delete cascade,
+    uploader_id  bigint                                             not null
+        constraint fk_task_attachment_uploader
+            references "user"
+            on update cascade on delete cascade,
+    name         varchar(255)                                       not null,
+    content_type varchar(127)                                       not null,
+    size         bigint                                             not null,
+    storage_key  varchar(255)                                       not null
+        constraint task_attachment_storage_key_key
+            unique,
+    created_at   timestamp with time zone default CURRENT_TIMESTAMP not null
+);
+
+create index task_attachment_task_id_idx
+    on task_attachment (task_id);
@@ -0,0 +1,3 @@
+drop table task_due_reminder;
+alter table task drop column due_at;
+alter table task drop column start_at;
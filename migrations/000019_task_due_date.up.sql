@@ -0,0 +1,22 @@
+alter table task
+    add start_at timestamp with time zone,
+    add due_at   timestamp with time zone;
+
+alter table task
+    add constraint check_task_due_at
+        check (due_at >= start_at);
+
+create index task_due_at_idx
+    on task (due_at)
+    where due_at is not null;
+
+-- due date of the task members were reminded about, changed due date is reminded again
+create table task_due_reminder
+(
+    task_id bigint                   not null
+        constraint fk_task_due_reminder_task
+            references task
+            on update cascade on delete cascade,
+    due_at  timestamp with time zone not null,
+    primary key (task_id, due_at)
+);
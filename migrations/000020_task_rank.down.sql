@@ -0,0 +1,5 @@
+drop trigger task_status_rank on task;
+drop function set_task_rank;
+drop function rank_after;
+alter table task drop column rank;
+alter table task drop column priority;
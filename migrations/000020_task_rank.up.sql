@@ -0,0 +1,69 @@
+-- priority is 1 (low) to 4 (urgent)
+alter table task
+    add priority smallint default 2 not null
+        constraint check_task_priority
+            check (priority between 1 and 4);
+
+-- rank orders tasks within their status column on the board, ranks are compared bytewise
+alter table task
+    add rank text collate "C";
+
+-- existing tasks keep their creation order, digits are padded to compare as numbers
+update task
+set rank = lpad(ranked.n::text, 10, '0') || 'i'
+from (select id, row_number() over (partition by status_id order by id) as n from task) ranked
+where ranked.id = task.id;
+
+alter table task
+    alter column rank set not null;
+
+create index task_status_id_rank_idx
+    on task (status_id, rank);
+
+-- rank_after returns the least short rank greater than the given one, see pkg/rank
+create function rank_after(prev text) returns text as
+$$
+declare
+    digits constant text := '0123456789abcdefghijklmnopqrstuvwxyz';
+    prefix          text := '';
+    digit           int;
+begin
+    prev = coalesce(prev, '');
+    loop
+        if prev = '' then
+            return prefix || 'i';
+        end if;
+        digit = strpos(digits, left(prev, 1)) - 1;
+        if digit < 35 then
+            return prefix || substr(digits, (digit + 36) / 2 + 1, 1);
+        end if;
+        prefix = prefix || 'z';
+        prev = substr(prev, 2);
+    end loop;
+end;
+$$ language plpgsql immutable;
+
+-- created task and task moved to another status are placed at the end of the column.
+-- Status row is locked, so concurrent tasks get different ranks
+create function set_task_rank() returns trigger as
+$$
+begin
+    if tg_op = 'UPDATE' and (new.status_id is not distinct from old.status_id or new.rank is distinct from old.rank) then
+        return new;
+    end if;
+    perform from task_status where id = new.status_id for no key update;
+    select rank_after(max(rank))
+    into new.rank
+    from task
+    where status_id = new.status_id
+      and id <> new.id;
+    return new;
+end;
+$$ language plpgsql;
+
+-- fires after task_set_status, which chooses status of the created task
+create trigger task_status_rank
+    before insert or update of status_id
+    on task
+    for each row
+execute function set_task_rank();
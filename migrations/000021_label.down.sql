@@ -0,0 +1,2 @@
+drop table task_label;
+drop table label;
@@ -0,0 +1,30 @@
+create table label
+(
+    id         bigserial
+        primary key,
+    project_id bigint not null
+        constraint fk_label_project
+            references project
+            on update cascade on delete cascade,
+    name       text   not null,
+    color      text   default '#9e9e9e' not null
+);
+
+create unique index label_project_id_name_idx
+    on label (project_id, name);
+
+create table task_label
+(
+    task_id  bigint not null
+        constraint fk_task_label_task
+            references task
+            on update cascade on delete cascade,
+    label_id bigint not null
+        constraint fk_task_label_label
+            references label
+            on update cascade on delete cascade,
+    primary key (task_id, label_id)
+);
+
+create index task_label_label_id_idx
+    on task_label (label_id);
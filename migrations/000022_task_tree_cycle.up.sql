@@ -0,0 +1,14 @@
+-- task_root_id stops at cycles of parents instead of recursing forever
+create or replace function task_root_id(bigint) returns bigint as
+$$
+with recursive ancestor as (select id, parent_id
+                            from task
+                            where id = $1
+                            union
+                            select task.id, task.parent_id
+                            from task
+                                     inner join ancestor on task.id = ancestor.parent_id)
+select id
+from ancestor
+where parent_id is null;
+$$ language sql stable;
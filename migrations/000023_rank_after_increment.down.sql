@@ -0,0 +1,21 @@
+create or replace function rank_after(prev text) returns text as
+$$
+declare
+    digits constant text := '0123456789abcdefghijklmnopqrstuvwxyz';
+    prefix          text := '';
+    digit           int;
+begin
+    prev = coalesce(prev, '');
+    loop
+        if prev = '' then
+            return prefix || 'i';
+        end if;
+        digit = strpos(digits, left(prev, 1)) - 1;
+        if digit < 35 then
+            return prefix || substr(digits, (digit + 36) / 2 + 1, 1);
+        end if;
+        prefix = prefix || 'z';
+        prev = substr(prev, 2);
+    end loop;
+end;
+$$ language plpgsql immutable;
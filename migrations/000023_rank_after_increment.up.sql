@@ -0,0 +1,34 @@
+-- rank_after increments leading digits of the rank instead of halving the distance to the end of the column,
+-- so ranks of appended tasks don't grow, see pkg/rank
+create or replace function rank_after(prev text) returns text as
+$$
+declare
+    digits constant text := '0123456789abcdefghijklmnopqrstuvwxyz';
+    width  constant int  := 10;
+    head            text := rpad(left(coalesce(prev, ''), width), width, '0');
+    prefix          text := '';
+    digit           int;
+begin
+    for i in reverse width..1
+        loop
+            digit = strpos(digits, substr(head, i, 1)) - 1;
+            if digit < 35 then
+                return left(head, i - 1) || substr(digits, digit + 2, 1);
+            end if;
+        end loop;
+
+    -- every leading digit is the last one
+    prev = coalesce(prev, '');
+    loop
+        if prev = '' then
+            return prefix || 'i';
+        end if;
+        digit = strpos(digits, left(prev, 1)) - 1;
+        if digit < 35 then
+            return prefix || substr(digits, (digit + 36) / 2 + 1, 1);
+        end if;
+        prefix = prefix || 'z';
+        prev = substr(prev, 2);
+    end loop;
+end;
+$$ language plpgsql immutable;
@@ -0,0 +1,3 @@
+drop trigger task_attachment_storage_orphan on task_attachment;
+drop function queue_storage_orphan;
+drop table storage_orphan;
@@ -0,0 +1,22 @@
+-- storage_orphan queues stored files of deleted attachments, they're removed from the storage by the cleanup worker.
+-- Attachments are deleted with their tasks, projects and uploaders by cascade, so keys are queued by trigger
+create table storage_orphan
+(
+    storage_key varchar(255)                                       not null
+        primary key,
+    deleted_at  timestamp with time zone default CURRENT_TIMESTAMP not null
+);
+
+create function queue_storage_orphan() returns trigger as
+$$
+begin
+    insert into storage_orphan (storage_key) values (old.storage_key) on conflict do nothing;
+    return old;
+end;
+$$ language plpgsql;
+
+create trigger task_attachment_storage_orphan
+    after delete
+    on task_attachment
+    for each row
+execute function queue_storage_orphan();
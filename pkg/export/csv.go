@@ -0,0 +1,43 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type csvWriter struct {
+	w      *csv.Writer
+	header []string
+}
+
+func newCSVWriter(w io.Writer, header []string) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w), header: header}
+}
+
+func (c *csvWriter) Write(record []interface{}) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	cells := make([]string, len(record))
+	for i, value := range record {
+		cells[i] = formatValue(value)
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.header == nil {
+		return nil
+	}
+	header := c.header
+	c.header = nil
+	return c.w.Write(header)
+}
@@ -0,0 +1,85 @@
+// Package export writes tabular data as CSV or XLSX row by row, so large results are never kept in memory
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	ContentTypeCSV  = "text/csv"
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// Writer writes the header before the first record. Close must be called to complete the file
+type Writer interface {
+	Write(record []interface{}) error
+	Close() error
+}
+
+// NewWriter returns writer of the format, CSV by default
+func NewWriter(format string, w io.Writer, header []string) Writer {
+	if format == FormatXLSX {
+		return newXLSXWriter(w, header)
+	}
+	return newCSVWriter(w, header)
+}
+
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return ContentTypeXLSX
+	}
+	return ContentTypeCSV
+}
+
+// deref returns the value of the pointer, nil pointers are nil values
+func deref(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *string:
+		if v != nil {
+			return *v
+		}
+	case *int64:
+		if v != nil {
+			return *v
+		}
+	case *bool:
+		if v != nil {
+			return *v
+		}
+	case *time.Time:
+		if v != nil {
+			return *v
+		}
+	default:
+		return value
+	}
+	return nil
+}
+
+// formatValue formats the cell as text. Nil pointers are empty cells
+func formatValue(value interface{}) string {
+	switch v := deref(value).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
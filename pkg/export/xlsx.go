@@ -0,0 +1,139 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+const (
+	xlsxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd   = `</sheetData></worksheet>`
+)
+
+// xlsxWriter writes single sheet workbook. Cells are inline strings, numbers and booleans without styles
+type xlsxWriter struct {
+	out    io.Writer
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	header []string
+	row    int
+}
+
+func newXLSXWriter(w io.Writer, header []string) *xlsxWriter {
+	return &xlsxWriter{out: w, header: header}
+}
+
+func (x *xlsxWriter) Write(record []interface{}) error {
+	if err := x.start(); err != nil {
+		return err
+	}
+	return x.writeRow(record)
+}
+
+func (x *xlsxWriter) Close() error {
+	if err := x.start(); err != nil {
+		return err
+	}
+	if _, err := x.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zip.Close()
+}
+
+// start writes workbook parts and the header when the first row is written, so nothing is written on early errors
+func (x *xlsxWriter) start() error {
+	if x.zip != nil {
+		return nil
+	}
+	x.zip = zip.NewWriter(x.out)
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, part := range parts {
+		w, err := x.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, part.content); err != nil {
+			return err
+		}
+	}
+
+	w, err := x.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	x.sheet = bufio.NewWriter(w)
+	if _, err = x.sheet.WriteString(xlsxSheetStart); err != nil {
+		return err
+	}
+	header := make([]interface{}, len(x.header))
+	for i, name := range x.header {
+		header[i] = name
+	}
+	return x.writeRow(header)
+}
+
+func (x *xlsxWriter) writeRow(record []interface{}) error {
+	x.row++
+	row := strconv.Itoa(x.row)
+	x.sheet.WriteString(`<row r="` + row + `">`)
+	for i, value := range record {
+		ref := columnName(i) + row
+		switch v := deref(value).(type) {
+		case int64, int, float64:
+			x.sheet.WriteString(`<c r="` + ref + `"><v>` + formatValue(v) + `</v></c>`)
+		case bool:
+			b := "0"
+			if v {
+				b = "1"
+			}
+			x.sheet.WriteString(`<c r="` + ref + `" t="b"><v>` + b + `</v></c>`)
+		default:
+			text := formatValue(v)
+			if text == "" {
+				continue
+			}
+			x.sheet.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+			if err := xml.EscapeText(x.sheet, []byte(text)); err != nil {
+				return err
+			}
+			x.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	_, err := x.sheet.WriteString(`</row>`)
+	return err
+}
+
+// columnName converts zero based column index to A, B, ..., Z, AA, AB and so on
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
@@ -40,7 +40,30 @@ var (
 	InvalidJWTToken       = errors.New("Invalid JWT token")
 	InvalidJWTClaims      = errors.New("Invalid JWT claims")
 	NotAllowedImageHeader = errors.New("Not allowed image header")
+	NotAllowedFileType    = errors.New("Not allowed file type")
+	FileTooLarge          = errors.New("File is too large")
 	NoCookie              = errors.New("not found cookie header")
+	InvalidTimeRange      = errors.New("Invalid time range")
+	OverlappingTimeEntry  = errors.New("Time entry overlaps with another one")
+	TimerAlreadyStarted   = errors.New("Timer already started")
+	NoBillableTimeEntries = errors.New("No billable time entries to invoice")
+	InvoicedTimeEntry     = errors.New("Time entry is invoiced")
+	InvalidInvoiceStatus  = errors.New("Invalid invoice status transition")
+	ApprovedTimeEntry     = errors.New("Time entry belongs to approved timesheet")
+	SubmittedTimesheet    = errors.New("Timesheet already submitted")
+	InvalidTimesheetState = errors.New("Invalid timesheet status transition")
+	RunningTimeEntry      = errors.New("Week has a running time entry")
+	LockedPeriod          = errors.New("Period is locked")
+	BudgetExceeded        = errors.New("Project budget is exceeded")
+	TaskStatusInUse       = errors.New("Task status is used by tasks")
+	LastTaskStatus        = errors.New("Last task status of the project can't be deleted")
+	InvalidTaskTransition = errors.New("Task status transition is not allowed")
+	InvalidTaskParent     = errors.New("Task can't be a subtask of this task")
+	TaskDepthExceeded     = errors.New("Subtasks are nested too deep")
+	InvalidTaskMove       = errors.New("Task can't be moved next to this task")
+	InvalidTaskDependency = errors.New("Task can't be blocked by this task")
+	DependencyCycle       = errors.New("Task dependencies can't make a cycle")
+	BlockedTask           = errors.New("Task is blocked by unfinished tasks")
 )
 
 // Rest error interface
@@ -155,6 +178,11 @@ func NewInternalServerError(causes interface{}) RestErr {
 
 // Parser of error string messages returns RestError
 func ParseErrors(err error) RestErr {
+	// Rest errors are already parsed, their causes may contain anything
+	var restErr RestErr
+	if errors.As(err, &restErr) {
+		return restErr
+	}
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, NotFound.Error(), err)
@@ -183,14 +211,18 @@ func ParseErrors(err error) RestErr {
 	case strings.Contains(strings.ToLower(err.Error()), "bcrypt"):
 		return NewRestError(http.StatusForbidden, WrongCredentials.Error(), err)
 	default:
-		if restErr, ok := err.(RestErr); ok {
-			return restErr
-		}
 		return NewInternalServerError(err)
 	}
 }
 
 func parseSqlErrors(err error) RestErr {
+	// Open time entry index allows one running timer of the task per user
+	if strings.Contains(err.Error(), "time_entry_open_task_id_user_id_idx") {
+		return NewRestError(http.StatusConflict, TimerAlreadyStarted.Error(), err)
+	}
+	if strings.Contains(err.Error(), "check_task_due_at") {
+		return NewRestError(http.StatusBadRequest, InvalidTimeRange.Error(), "due_at is before start_at")
+	}
 	if strings.Contains(err.Error(), "23505") {
 		return NewRestError(http.StatusBadRequest, "Entity already exists", err)
 	}
@@ -0,0 +1,150 @@
+// Package pdf renders plain text documents in PDF format without external dependencies.
+// Documents use standard monospaced Courier font, so text width is known without font metrics
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	PageWidth  = 595.0 // A4 width in points
+	PageHeight = 842.0 // A4 height in points
+	Margin     = 50.0
+
+	// charWidth is width of Courier glyph relative to the font size
+	charWidth   = 0.6
+	lineSpacing = 1.4
+)
+
+// Cell is a text placed in the row at X points from the left margin
+type Cell struct {
+	X          float64
+	Text       string
+	AlignRight bool
+}
+
+type text struct {
+	x, y float64
+	size float64
+	bold bool
+	text string
+}
+
+// Document is a multipage document filled from top to bottom
+type Document struct {
+	pages [][]text
+	y     float64
+}
+
+func New() *Document {
+	d := &Document{}
+	d.newPage()
+	return d
+}
+
+// Line writes text on the new line
+func (d *Document) Line(size float64, bold bool, s string) {
+	d.Row(size, bold, Cell{Text: s})
+}
+
+// Row writes cells on the new line, starting the new page if there is no space left
+func (d *Document) Row(size float64, bold bool, cells ...Cell) {
+	height := size * lineSpacing
+	if d.y-height < Margin {
+		d.newPage()
+	}
+	d.y -= height
+
+	page := len(d.pages) - 1
+	for _, cell := range cells {
+		x := Margin + cell.X
+		if cell.AlignRight {
+			x -= TextWidth(size, cell.Text)
+		}
+		d.pages[page] = append(d.pages[page], text{x: x, y: d.y, size: size, bold: bold, text: cell.Text})
+	}
+}
+
+// Space moves the cursor down by the points
+func (d *Document) Space(points float64) {
+	d.y -= points
+}
+
+// TextWidth returns width of the text in points
+func TextWidth(size float64, s string) float64 {
+	return float64(len([]rune(s))) * size * charWidth
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, nil)
+	d.y = PageHeight - Margin
+}
+
+// WriteTo writes the document in PDF format
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var objects []string
+	// 1 - catalog, 2 - pages, 3 - regular font, 4 - bold font, then page and its content for every page
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+i*2))
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+	)
+	for i, page := range d.pages {
+		var content bytes.Buffer
+		for _, t := range page {
+			font := "F1"
+			if t.bold {
+				font = "F2"
+			}
+			fmt.Fprintf(&content, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, t.size, t.x, t.y, escape(t.text))
+		}
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] "+
+				"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", PageWidth, PageHeight, 6+i*2),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, 0, len(objects))
+	for i, object := range objects {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// escape escapes PDF string delimiters and replaces characters missing in WinAnsiEncoding
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r < 127:
+			b.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,97 @@
+// Package rank generates lexicographic ranks ordering items of a list. A rank between any two ranks can be
+// generated, so an item is moved by changing its own rank only
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+// digits of ranks in ascending order, ranks are compared bytewise
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// appendWidth is the number of leading digits incremented by After, it's the width of ranks of existing tasks
+// backfilled by the migration. The list takes 36^appendWidth appends before ranks grow
+const appendWidth = 10
+
+var ErrInvalidRange = errors.New("rank: prev must be less than next")
+
+// Between returns a rank greater than prev and less than next. Empty prev is the start of the list
+// and empty next is its end. Generated ranks never end with the zero digit, so there is always room before them
+func Between(prev, next string) (string, error) {
+	if next != "" && prev >= next || !valid(prev) || !valid(next) {
+		return "", ErrInvalidRange
+	}
+	return midpoint(prev, next), nil
+}
+
+// After returns a rank greater than prev, it's the rank of the item appended to the list. The leading digits
+// of prev are incremented, so appended ranks don't grow unlike ranks halving the distance to the end of the list
+func After(prev string) (string, error) {
+	if !valid(prev) {
+		return "", ErrInvalidRange
+	}
+	n := min(len(prev), appendWidth)
+	head := []byte(prev[:n] + strings.Repeat(digits[:1], appendWidth-n))
+	for i := appendWidth - 1; i >= 0; i-- {
+		digit := strings.IndexByte(digits, head[i])
+		if digit < len(digits)-1 {
+			// Digits after the incremented one are zeros, which ranks don't end with
+			head[i] = digits[digit+1]
+			return string(head[:i+1]), nil
+		}
+	}
+	return midpoint(prev, ""), nil
+}
+
+func midpoint(prev, next string) string {
+	if next != "" {
+		// Common prefix is kept, missing digits of prev are zeros
+		n := 0
+		for n < len(next) && digitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			return next[:n] + midpoint(prev[min(n, len(prev)):], next[n:])
+		}
+	}
+
+	low, high := 0, len(digits)
+	if prev != "" {
+		low = strings.IndexByte(digits, prev[0])
+	}
+	if next != "" {
+		high = strings.IndexByte(digits, next[0])
+	}
+	if high-low > 1 {
+		return string(digits[(low+high)/2])
+	}
+	// First digits are consecutive. The first digit of longer next is already less than next
+	if len(next) > 1 {
+		return next[:1]
+	}
+	tail := ""
+	if prev != "" {
+		tail = prev[1:]
+	}
+	return string(digits[low]) + midpoint(tail, "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func valid(rank string) bool {
+	if strings.HasSuffix(rank, digits[:1]) {
+		return false
+	}
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(digits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package rank
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		prev, next, want string
+	}{
+		{"", "", "i"},
+		{"i", "", "r"},
+		{"", "i", "9"},
+		{"1", "2", "1i"},
+		{"1", "21", "2"},
+		{"", "1", "0i"},
+		{"", "0i", "09"},
+		{"z", "", "zi"},
+		{"0000000001i", "0000000002i", "0000000002"},
+		{"0000000001i", "0000000001j", "0000000001ii"},
+	}
+	for _, tt := range tests {
+		got, err := Between(tt.prev, tt.next)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "between %q and %q", tt.prev, tt.next)
+		assert.Less(t, tt.prev, got)
+		if tt.next != "" {
+			assert.Less(t, got, tt.next)
+		}
+	}
+
+	for _, tt := range [][2]string{{"b", "a"}, {"a", "a"}, {"a0", ""}, {"", "A"}} {
+		_, err := Between(tt[0], tt[1])
+		assert.ErrorIs(t, err, ErrInvalidRange)
+	}
+}
+
+func TestBetweenRandomMoves(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	ranks := []string{}
+	for i := 0; i < 1000; i++ {
+		// Inserted at random position between its neighbours
+		pos := random.Intn(len(ranks) + 1)
+		prev, next := "", ""
+		if pos > 0 {
+			prev = ranks[pos-1]
+		}
+		if pos < len(ranks) {
+			next = ranks[pos]
+		}
+		rank, err := Between(prev, next)
+		require.NoError(t, err)
+		ranks = append(ranks[:pos], append([]string{rank}, ranks[pos:]...)...)
+	}
+	for i := 1; i < len(ranks); i++ {
+		require.Less(t, ranks[i-1], ranks[i])
+	}
+
+	rank, err := After(ranks[len(ranks)-1])
+	require.NoError(t, err)
+	assert.Less(t, ranks[len(ranks)-1], rank)
+}
+
+func TestAfter(t *testing.T) {
+	tests := []struct {
+		prev, want string
+	}{
+		{"", "0000000001"},
+		{"i", "i000000001"},
+		{"0000000001i", "0000000002"},
+		{"00000000zz", "00000001"},
+		{"zzzzzzzzzz", "zzzzzzzzzzi"},
+	}
+	for _, tt := range tests {
+		got, err := After(tt.prev)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "after %q", tt.prev)
+		assert.Less(t, tt.prev, got)
+	}
+
+	// Appended ranks don't grow
+	prev := ""
+	for i := 0; i < 20000; i++ {
+		rank, err := After(prev)
+		require.NoError(t, err)
+		require.Less(t, prev, rank)
+		require.LessOrEqual(t, len(rank), appendWidth)
+		prev = rank
+	}
+
+	_, err := After("a0")
+	assert.ErrorIs(t, err, ErrInvalidRange)
+}
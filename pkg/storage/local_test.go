@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+
+	storage, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	testStorage(ctx, t, storage)
+
+	assert.NotNil(t, storage.Put(ctx, "../outside.txt", strings.NewReader("lorem"), 5, "text/plain"))
+}
+
+// testStorage checks behaviour every storage backend shares
+func testStorage(ctx context.Context, t *testing.T, storage Storage) {
+	key, content := "tasks/1/lorem.txt", "lorem ipsum"
+
+	_, err := storage.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, storage.Put(ctx, key, strings.NewReader(content), int64(len(content)), "text/plain"))
+	file, err := storage.Get(ctx, key)
+	require.NoError(t, err)
+	gotContent, err := io.ReadAll(file)
+	assert.Nil(t, err)
+	assert.Nil(t, file.Close())
+	assert.Equal(t, content, string(gotContent))
+
+	assert.Nil(t, storage.Delete(ctx, key))
+	_, err = storage.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, storage.Delete(ctx, key))
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"io"
+	"net/http"
+)
+
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Storage stores files in the bucket of S3-compatible storage, the bucket is created if missing
+func NewS3Storage(ctx context.Context, cfg *Config) (Storage, error) {
+	client, err := minio.New(cfg.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3AccessKey, cfg.S3SecretKey, ""),
+		Secure: cfg.S3UseSSL,
+		Region: cfg.S3Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage.NewS3Storage.New: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.S3Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("storage.NewS3Storage.BucketExists: %w", err)
+	}
+	if !exists {
+		if err = client.MakeBucket(ctx, cfg.S3Bucket, minio.MakeBucketOptions{Region: cfg.S3Region}); err != nil {
+			return nil, fmt.Errorf("storage.NewS3Storage.MakeBucket: %w", err)
+		}
+	}
+	return s3Storage{client: client, bucket: cfg.S3Bucket}, nil
+}
+
+func (s s3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	// Stat is requested first since GetObject reports missing objects on the first read only
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		return nil, s.parseError(err)
+	}
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.parseError(err)
+	}
+	return object, nil
+}
+
+func (s s3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s s3Storage) parseError(err error) error {
+	if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
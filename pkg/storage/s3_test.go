@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"log"
+	"testing"
+)
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+
+	minioC, cfg := SetupMinIO(ctx)
+	defer func() {
+		if err := minioC.Terminate(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	storage, err := NewStorage(ctx, cfg)
+	require.NoError(t, err)
+	testStorage(ctx, t, storage)
+}
+
+// SetupMinIO launches local MinIO instance via testcontainers.
+// Returned testcontainers.Container MUST be terminated
+func SetupMinIO(ctx context.Context) (testcontainers.Container, *Config) {
+	cfg := &Config{
+		Type:        TypeS3,
+		S3AccessKey: "minioadmin",
+		S3SecretKey: "minioadmin",
+		S3Bucket:    "attachments",
+		S3Region:    "us-east-1",
+	}
+	req := testcontainers.ContainerRequest{
+		Image:        "minio/minio:latest",
+		ExposedPorts: []string{"9000/tcp"},
+		Cmd:          []string{"server", "/data"},
+		Env: map[string]string{
+			"MINIO_ROOT_USER":     cfg.S3AccessKey,
+			"MINIO_ROOT_PASSWORD": cfg.S3SecretKey,
+		},
+		WaitingFor: wait.ForHTTP("/minio/health/live").WithPort("9000/tcp"),
+	}
+	minioC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		log.Fatalf("Could not start minio: %s", err)
+	}
+	if cfg.S3Endpoint, err = minioC.Endpoint(ctx, ""); err != nil {
+		log.Fatal(err)
+	}
+	return minioC, cfg
+}
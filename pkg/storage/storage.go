@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	TypeLocal = "local" // files are stored in the directory of the local filesystem
+	TypeS3    = "s3"    // files are stored in the bucket of S3-compatible storage
+)
+
+// ErrNotFound is returned when there is no object with the key
+var ErrNotFound = errors.New("storage object not found")
+
+// Storage keeps uploaded files by keys. Keys are slash-separated paths, deleting missing key isn't an error
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Type        string
+	Dir         string
+	S3Endpoint  string
+	S3AccessKey string
+	S3SecretKey string
+	S3Bucket    string
+	S3Region    string
+	S3UseSSL    bool
+}
+
+func NewStorage(ctx context.Context, cfg *Config) (Storage, error) {
+	switch cfg.Type {
+	case TypeLocal:
+		return NewLocalStorage(cfg.Dir)
+	case TypeS3:
+		return NewS3Storage(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("storage.NewStorage: unknown storage type %q", cfg.Type)
+	}
+}
@@ -1,13 +1,17 @@
 package utils
 
 import (
+	"context"
+	"fmt"
 	"github.com/armanokka/time_tracker/config"
 	"github.com/armanokka/time_tracker/internal/models"
+	"github.com/armanokka/time_tracker/pkg/httpErrors"
 	"github.com/armanokka/time_tracker/pkg/logger"
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"net/http"
+	"time"
 )
 
 const UserCtxKey = "ctx"
@@ -44,7 +48,12 @@ func ReadRequest(c *gin.Context, request interface{}) error {
 	if err := c.Bind(request); err != nil {
 		return err
 	}
-	return validate.StructCtx(c, request)
+	return ValidateStruct(c, request)
+}
+
+// ValidateStruct validates the struct by the rules of ReadRequest
+func ValidateStruct(ctx context.Context, s interface{}) error {
+	return validate.StructCtx(ctx, s)
 }
 
 type UsersQueryResponse struct {
@@ -80,3 +89,194 @@ func (u UsersQuery) GetOffset() int {
 	}
 	return u.Page * u.Limit
 }
+
+const (
+	defaultTasksQueryLimit = 50
+
+	TasksSortByID        = "id"
+	TasksSortByName      = "name"
+	TasksSortByCreatedAt = "created_at"
+	TasksSortByUpdatedAt = "updated_at"
+	TasksSortByPriority  = "priority"
+	// TasksSortByBoard orders tasks by status position and rank within the status
+	TasksSortByBoard = "board"
+
+	LabelMatchAny = "any"
+	LabelMatchAll = "all"
+)
+
+// TasksQuery filters tasks of the project, empty filters aren't applied.
+// Sort is the column with optional "-" prefix for descending order, Cursor is next_cursor of the previous page
+type TasksQuery struct {
+	StatusIDs   []int64    `json:"status_id" form:"status_id" validate:"omitempty"`
+	Finished    *bool      `json:"finished" form:"finished" validate:"omitempty"`
+	AssigneeID  int64      `json:"assignee_id" form:"assignee_id" validate:"omitempty"`
+	Search      string     `json:"search" form:"search" validate:"omitempty,lte=64"`
+	CreatedFrom *time.Time `json:"created_from" form:"created_from" validate:"omitempty"`
+	CreatedTo   *time.Time `json:"created_to" form:"created_to" validate:"omitempty"`
+	UpdatedFrom *time.Time `json:"updated_from" form:"updated_from" validate:"omitempty"`
+	UpdatedTo   *time.Time `json:"updated_to" form:"updated_to" validate:"omitempty"`
+	// ParentID selects subtasks of the task, zero selects top-level tasks
+	ParentID *int64 `json:"parent_id" form:"parent_id" validate:"omitempty,gte=0"`
+	// LabelIDs selects tasks with any of the labels, or with all of them if LabelMatch is all
+	LabelIDs   []int64 `json:"label_id" form:"label_id" validate:"omitempty"`
+	LabelMatch string  `json:"label_match" form:"label_match" validate:"omitempty,oneof=any all"`
+	Sort       string  `json:"sort" form:"sort" validate:"omitempty,oneof=board id -id name -name created_at -created_at updated_at -updated_at priority -priority"`
+	Limit      int     `json:"limit" form:"limit" validate:"omitempty,gte=1,lte=500"`
+	Cursor     string  `json:"cursor" form:"cursor" validate:"omitempty"`
+}
+
+func (q TasksQuery) GetLimit() int {
+	if q.Limit == 0 {
+		return defaultTasksQueryLimit
+	}
+	return q.Limit
+}
+
+// GetSort returns sort column and direction, tasks are in board order by default
+func (q TasksQuery) GetSort() (column string, desc bool) {
+	if q.Sort == "" {
+		return TasksSortByBoard, false
+	}
+	if q.Sort[0] == '-' {
+		return q.Sort[1:], true
+	}
+	return q.Sort, false
+}
+
+// TasksQueryResponse is the page of tasks, NextCursor is empty on the last page
+type TasksQueryResponse struct {
+	Tasks      []*models.Task `json:"tasks"`
+	Count      int            `json:"count"`
+	TotalCount int            `json:"total_count"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+const (
+	BulkCreate        = "create"
+	BulkUpdate        = "update"
+	BulkDelete        = "delete"
+	BulkAddMembers    = "add_members"
+	BulkDeleteMembers = "delete_members"
+)
+
+// BulkTasksRequest is the batch of changes to tasks of the project applied in one transaction.
+// Items are validated one by one, so errors are reported per item
+type BulkTasksRequest struct {
+	Create        []*models.Task    `json:"create" validate:"max=100"`
+	Update        []*models.Task    `json:"update" validate:"max=100"`
+	Delete        []int64           `json:"delete" validate:"max=100"`
+	AddMembers    []*BulkTaskMember `json:"add_members" validate:"max=100"`
+	DeleteMembers []*BulkTaskMember `json:"delete_members" validate:"max=100"`
+}
+
+type BulkTaskMember struct {
+	TaskID int64 `json:"task_id" validate:"required"`
+	UserID int64 `json:"user_id" validate:"required"`
+}
+
+// BulkTasksResponse has results in the order of request items. Nothing is applied unless all items are ok
+type BulkTasksResponse struct {
+	Ok            bool              `json:"ok"`
+	Create        []*BulkTaskResult `json:"create"`
+	Update        []*BulkTaskResult `json:"update"`
+	Delete        []*BulkTaskResult `json:"delete"`
+	AddMembers    []*BulkTaskResult `json:"add_members"`
+	DeleteMembers []*BulkTaskResult `json:"delete_members"`
+}
+
+// BulkTaskResult is the created or updated task, or the error of the item
+type BulkTaskResult struct {
+	Ok    bool               `json:"ok"`
+	Task  *models.Task       `json:"task,omitempty"`
+	Error httpErrors.RestErr `json:"error,omitempty"`
+}
+
+// BulkTaskError is the error of the batch item which rolled back the transaction
+type BulkTaskError struct {
+	Op    string
+	Index int
+	Err   error
+}
+
+func (e *BulkTaskError) Error() string {
+	return fmt.Sprintf("%s[%d]: %s", e.Op, e.Index, e.Err)
+}
+
+func (e *BulkTaskError) Unwrap() error {
+	return e.Err
+}
+
+type TimeEntriesQuery struct {
+	UserID int64      `json:"user_id" form:"user_id"`
+	From   *time.Time `json:"from" form:"from"`
+	To     *time.Time `json:"to" form:"to"`
+	TagID  int64      `json:"tag_id" form:"tag_id"`
+}
+
+const (
+	GroupByTask = "task"
+	GroupByTag  = "tag"
+)
+
+type ProductivityQuery struct {
+	TagID   int64  `json:"tag_id" form:"tag_id" validate:"omitempty"`
+	GroupBy string `json:"group_by" form:"group_by" validate:"omitempty,oneof=task tag"`
+	// Approved counts entries of approved timesheets only
+	Approved bool `json:"approved" form:"approved"`
+}
+
+type TimesheetsQuery struct {
+	UserID int64  `json:"user_id" form:"user_id" validate:"omitempty"`
+	Status string `json:"status" form:"status" validate:"omitempty,oneof=submitted approved rejected"`
+}
+
+type DueTasksQuery struct {
+	// Within is the window of due soon tasks, the server default if zero
+	Within time.Duration `json:"within" form:"within" validate:"omitempty,gte=0"`
+}
+
+type NotificationsQuery struct {
+	Unread bool `json:"unread" form:"unread"`
+	// Limit is the number of the newest notifications, 50 by default
+	Limit int `json:"limit" form:"limit" validate:"omitempty,gte=1,lte=500"`
+}
+
+type CommentsQuery struct {
+	// After is the id of the last comment of the previous page
+	After int64 `json:"after" form:"after" validate:"omitempty,gte=0"`
+	// Limit is the number of the oldest comments after the cursor, 100 by default
+	Limit int `json:"limit" form:"limit" validate:"omitempty,gte=1,lte=500"`
+}
+
+const (
+	ReportByProject = "project"
+	ReportByTask    = "task"
+	ReportByUser    = "user"
+	ReportByDay     = "day"
+	ReportByWeek    = "week"
+	ReportByMonth   = "month"
+	ReportByLabel   = "label"
+)
+
+// ReportFilter selects entries started in the date range, empty filters aren't applied
+type ReportFilter struct {
+	From       string  `json:"from" form:"from" validate:"required,datetime=2006-01-02"`
+	To         string  `json:"to" form:"to" validate:"required,datetime=2006-01-02"`
+	ProjectIDs []int64 `json:"project_id" form:"project_id" validate:"omitempty"`
+	TaskIDs    []int64 `json:"task_id" form:"task_id" validate:"omitempty"`
+	UserIDs    []int64 `json:"user_id" form:"user_id" validate:"omitempty"`
+	TagIDs     []int64 `json:"tag_id" form:"tag_id" validate:"omitempty"`
+	Billable   *bool   `json:"billable" form:"billable" validate:"omitempty"`
+	// Timezone is used for date boundaries, UTC by default
+	Timezone string `json:"timezone" form:"timezone" validate:"omitempty,timezone"`
+	// Rollup reports time of subtasks as time of their top-level task
+	Rollup bool `json:"rollup" form:"rollup"`
+	// Approved reports entries of approved timesheets only
+	Approved bool `json:"approved" form:"approved"`
+}
+
+type ReportQuery struct {
+	ReportFilter
+	GroupBy []string `json:"group_by" form:"group_by" validate:"required,min=1,max=2,dive,oneof=project task user day week month label"`
+}